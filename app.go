@@ -0,0 +1,321 @@
+package dhasar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fikrirnurhidayat/x/logger"
+	"github.com/labstack/echo/v4"
+)
+
+const DefaultShutdownTimeout = 30 * time.Second
+
+const (
+	ExitOK = iota
+	ExitFailure
+	ExitUsage
+)
+
+type Worker interface {
+	Run(ctx context.Context) error
+}
+
+type WorkerFunc func(ctx context.Context) error
+
+func (fn WorkerFunc) Run(ctx context.Context) error {
+	return fn(ctx)
+}
+
+type App struct {
+	Name            string
+	Config          *Config
+	Container       *Container
+	Logger          logger.Logger
+	Health          *Health
+	Server          *HTTPServer
+	Modules         HTTPModules
+	Workers         []Worker
+	Dependency      *RootDependency
+	configFile      string
+	envPrefix       string
+	watchConfig     bool
+	connect         func(*App) error
+	healthCheck     echo.HandlerFunc
+	errorRenderer   ErrorRenderer
+	drainPeriod     time.Duration
+	shutdownTimeout time.Duration
+}
+
+type AppOption struct {
+	Name            string
+	Config          *Config
+	ConfigFile      string
+	EnvPrefix       string
+	WatchConfig     bool
+	Logger          logger.Logger
+	Health          *Health
+	Container       *Container
+	Modules         HTTPModules
+	Workers         []Worker
+	Connect         func(*App) error
+	HealthCheck     echo.HandlerFunc
+	ErrorRenderer   ErrorRenderer
+	DrainPeriod     time.Duration
+	ShutdownTimeout time.Duration
+}
+
+func (a *App) Run(ctx context.Context) int {
+	if err := a.setup(); err != nil {
+		a.Logger.Error("app/SETUP", logger.String("error", err.Error()))
+
+		if err := a.shutdown(); err != nil {
+			a.Logger.Error("app/SHUTDOWN", logger.String("error", err.Error()))
+		}
+
+		return ExitFailure
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	code := ExitOK
+	if err := a.serve(ctx); err != nil {
+		a.Logger.Error("app/SERVE", logger.String("error", err.Error()))
+		code = ExitFailure
+	}
+
+	if err := a.shutdown(); err != nil {
+		a.Logger.Error("app/SHUTDOWN", logger.String("error", err.Error()))
+		code = ExitFailure
+	}
+
+	return code
+}
+
+func (a *App) setup() error {
+	if a.Config == nil {
+		config, err := LoadConfig(&ConfigOption{
+			File:      a.configFile,
+			EnvPrefix: a.envPrefix,
+		})
+		if err != nil {
+			return err
+		}
+
+		a.Config = config
+	} else if err := a.Config.Validate(); err != nil {
+		return err
+	}
+
+	if a.watchConfig {
+		watcher, err := NewConfigWatcher(a.Config, a.Logger)
+		if err != nil {
+			return err
+		}
+
+		watcher.Start()
+		Supply(a.Container, watcher)
+	}
+
+	if a.drainPeriod == 0 {
+		a.drainPeriod = a.Config.Server.DrainPeriod
+	}
+
+	if a.shutdownTimeout == 0 {
+		a.shutdownTimeout = a.Config.Server.ShutdownTimeout
+	}
+
+	if a.shutdownTimeout == 0 {
+		a.shutdownTimeout = DefaultShutdownTimeout
+	}
+
+	a.Logger.Debug("app/CONFIG", logger.String("status", "OK!"))
+
+	if a.connect != nil {
+		if err := a.connect(a); err != nil {
+			return err
+		}
+	}
+
+	modules, err := a.Modules.Sorted()
+	if err != nil {
+		return err
+	}
+
+	a.Modules = modules
+
+	a.Dependency, err = NewRootDependency(&RootDependencyOption{
+		Logger:    a.Logger,
+		Container: a.Container,
+		Config:    a.Config,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range a.Modules {
+		module.Wire(a.Dependency)
+	}
+
+	requirements := []Requirement{}
+	for _, module := range a.Modules {
+		requirements = append(requirements, RequirementsOf(module)...)
+	}
+
+	server, err := NewHTTPServer(&HTTPServerOption{
+		Server:          &a.Config.Server,
+		Container:       a.Container,
+		Logger:          a.Logger,
+		HealthCheck:     a.healthCheck,
+		Health:          a.Health,
+		ErrorRenderer:   a.errorRenderer,
+		Requirements:    requirements,
+		DrainPeriod:     a.drainPeriod,
+		ShutdownTimeout: a.shutdownTimeout,
+		Bootstrap: func(server *HTTPServer) error {
+			for _, module := range a.Modules {
+				if err := module.WireController(server.Echo); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	a.Server = server
+
+	return nil
+}
+
+func (a *App) serve(ctx context.Context) error {
+	errs := make(chan error, len(a.Workers))
+
+	serverCtx, stopServer := context.WithCancel(ctx)
+	defer stopServer()
+
+	serverDone := make(chan error, 1)
+	go func() {
+		serverDone <- a.Server.Start(serverCtx)
+	}()
+
+	workerCtx, cancelWorkers := context.WithCancel(ctx)
+	defer cancelWorkers()
+
+	var wg sync.WaitGroup
+	for _, worker := range a.Workers {
+		wg.Add(1)
+		go func(worker Worker) {
+			defer wg.Done()
+			if err := worker.Run(workerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				errs <- err
+			}
+		}(worker)
+	}
+
+	var err error
+	serverStopped := false
+	select {
+	case <-ctx.Done():
+		a.Logger.Info("app/SIGNAL", logger.String("status", "shutting down"))
+	case err = <-errs:
+	case err = <-serverDone:
+		serverStopped = true
+	}
+
+	stopServer()
+	if !serverStopped {
+		err = errors.Join(err, <-serverDone)
+	}
+
+	cancelWorkers()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-shutdownCtx.Done():
+		err = errors.Join(err, fmt.Errorf("workers did not stop: %w", shutdownCtx.Err()))
+	}
+
+	return err
+}
+
+func (a *App) shutdown() error {
+	timeout := a.shutdownTimeout
+	if timeout == 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := a.Container.Close(ctx); err != nil {
+		return err
+	}
+
+	a.Logger.Info("app/SHUTDOWN", logger.String("status", "OK!"))
+
+	return nil
+}
+
+func NewApp(opt *AppOption) (*App, error) {
+	if opt.Logger == nil {
+		return nil, errors.New("app logger is required")
+	}
+
+	app := &App{
+		Name:            opt.Name,
+		Config:          opt.Config,
+		Container:       opt.Container,
+		Logger:          opt.Logger,
+		Health:          opt.Health,
+		Modules:         opt.Modules,
+		Workers:         opt.Workers,
+		configFile:      opt.ConfigFile,
+		envPrefix:       opt.EnvPrefix,
+		watchConfig:     opt.WatchConfig,
+		connect:         opt.Connect,
+		healthCheck:     opt.HealthCheck,
+		errorRenderer:   opt.ErrorRenderer,
+		drainPeriod:     opt.DrainPeriod,
+		shutdownTimeout: opt.ShutdownTimeout,
+	}
+
+	if app.Container == nil {
+		app.Container = NewContainer()
+	}
+
+	if app.Health == nil {
+		app.Health = NewHealth(&HealthOption{})
+	}
+
+	if _, err := Resolve[*Health](app.Container); err != nil {
+		Supply(app.Container, app.Health)
+	}
+
+	if _, err := app.Container.Resolve("Logger"); err != nil {
+		app.Container.Register("Logger", app.Logger)
+	}
+
+	if _, err := Resolve[logger.Logger](app.Container); err != nil {
+		Supply(app.Container, app.Logger)
+	}
+
+	return app, nil
+}
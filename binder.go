@@ -0,0 +1,259 @@
+package dhasar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BinderFactory func(dest reflect.Value) BinderFunc
+
+var binders = struct {
+	mu        sync.RWMutex
+	factories map[reflect.Type]BinderFactory
+}{
+	factories: map[reflect.Type]BinderFactory{},
+}
+
+func RegisterBinder[T any](factory func(dest *T) BinderFunc) {
+	binders.mu.Lock()
+	defer binders.mu.Unlock()
+
+	binders.factories[typeOf[T]()] = func(dest reflect.Value) BinderFunc {
+		return factory(dest.Addr().Interface().(*T))
+	}
+}
+
+func binderOf(t reflect.Type) (BinderFactory, bool) {
+	binders.mu.RLock()
+	defer binders.mu.RUnlock()
+
+	factory, ok := binders.factories[t]
+	return factory, ok
+}
+
+type bindingSource struct {
+	location string
+	values   func(c echo.Context, name string) []string
+}
+
+var bindingSources = []bindingSource{
+	{
+		location: ErrorLocationPath,
+		values: func(c echo.Context, name string) []string {
+			if value := c.Param(name); value != "" {
+				return []string{value}
+			}
+
+			return nil
+		},
+	},
+	{
+		location: ErrorLocationQuery,
+		values: func(c echo.Context, name string) []string {
+			return c.QueryParams()[name]
+		},
+	},
+	{
+		location: ErrorLocationHeader,
+		values: func(c echo.Context, name string) []string {
+			return c.Request().Header.Values(name)
+		},
+	},
+}
+
+func Bind(c echo.Context, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind target must be a pointer to struct, got %T", target)
+	}
+
+	details := bindBody(c, target)
+
+	t := v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		for _, source := range bindingSources {
+			tag, ok := field.Tag.Lookup(source.location)
+			if !ok || tag == "-" {
+				continue
+			}
+
+			name, options, _ := strings.Cut(tag, ",")
+			values := source.values(c, name)
+			if len(values) == 0 {
+				if options == "required" {
+					details = append(details, ErrorDetail{
+						Field:    name,
+						Location: source.location,
+						Reason:   ErrRequiredValue.Reason,
+						Message:  ErrRequiredValue.Message,
+					})
+				}
+
+				continue
+			}
+
+			binder, err := bindFieldOf(v.Elem().Field(i))
+			if err != nil {
+				return fmt.Errorf("bind field %s: %w", field.Name, err)
+			}
+
+			details = append(details, ErrorDetailsOf(source.location, name, binder(values)...)...)
+		}
+	}
+
+	if len(details) > 0 {
+		return ErrBadRequest.WithDetails(details...)
+	}
+
+	return nil
+}
+
+func bindFieldOf(dest reflect.Value) (BinderFunc, error) {
+	if factory, ok := binderOf(dest.Type()); ok {
+		return factory(dest), nil
+	}
+
+	switch dest.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(dest.Type().Elem())
+		binder, err := bindFieldOf(elem.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(values []string) []error {
+			if errs := binder(values); len(errs) > 0 {
+				return errs
+			}
+
+			dest.Set(elem)
+
+			return nil
+		}, nil
+	case reflect.Slice:
+		factory, ok := binderOf(dest.Type().Elem())
+		if !ok {
+			break
+		}
+
+		return func(values []string) []error {
+			slice := reflect.MakeSlice(dest.Type(), len(values), len(values))
+			errs := []error{}
+			for i, value := range values {
+				for _, err := range factory(slice.Index(i))([]string{value}) {
+					errs = append(errs, indexBinderError(err, i))
+				}
+			}
+
+			if len(errs) > 0 {
+				return errs
+			}
+
+			dest.Set(slice)
+
+			return nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no binder registered for %s", dest.Type())
+}
+
+func hasBindingSource(field reflect.StructField) bool {
+	for _, source := range bindingSources {
+		if _, ok := field.Tag.Lookup(source.location); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bindBody(c echo.Context, target any) []ErrorDetail {
+	req := c.Request()
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0 {
+		return nil
+	}
+
+	if !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return nil
+	}
+
+	v := reflect.ValueOf(target).Elem()
+	body := reflect.New(v.Type())
+	body.Elem().Set(v)
+
+	err := json.NewDecoder(req.Body).Decode(body.Interface())
+	if err == nil {
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() && !hasBindingSource(v.Type().Field(i)) {
+				v.Field(i).Set(body.Elem().Field(i))
+			}
+		}
+
+		return nil
+	}
+
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return []ErrorDetail{{
+			Field:    typeErr.Field,
+			Location: ErrorLocationBody,
+			Reason:   ErrInvalidValue.Reason,
+			Message:  fmt.Sprintf("Value must be %s.", typeErr.Type),
+		}}
+	}
+
+	return []ErrorDetail{{
+		Location: ErrorLocationBody,
+		Reason:   ErrInvalidJSON.Reason,
+		Message:  ErrInvalidJSON.Message,
+	}}
+}
+
+func init() {
+	RegisterBinder(func(dest *string) BinderFunc {
+		return func(values []string) []error {
+			*dest = values[0]
+			return nil
+		}
+	})
+
+	RegisterBinder(func(dest *[]string) BinderFunc {
+		return func(values []string) []error {
+			*dest = append([]string{}, values...)
+			return nil
+		}
+	})
+
+	RegisterBinder(IntBinder[int])
+	RegisterBinder(IntBinder[int8])
+	RegisterBinder(IntBinder[int16])
+	RegisterBinder(IntBinder[int32])
+	RegisterBinder(IntBinder[int64])
+	RegisterBinder(IntBinder[uint])
+	RegisterBinder(IntBinder[uint8])
+	RegisterBinder(IntBinder[uint16])
+	RegisterBinder(IntBinder[uint32])
+	RegisterBinder(IntBinder[uint64])
+	RegisterBinder(BoolBinder)
+	RegisterBinder(TimeBinder)
+	RegisterBinder(DurationBinder)
+	RegisterBinder(MustUUIDBinder)
+}
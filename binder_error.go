@@ -0,0 +1,147 @@
+package dhasar
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BinderError struct {
+	Index   int
+	Value   string
+	Reason  string
+	Message string
+	Err     error
+}
+
+func (e *BinderError) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Message, e.Err.Error())
+}
+
+func (e *BinderError) Unwrap() error {
+	return e.Err
+}
+
+func NewBinderError(index int, value string, reason *Error, err error) *BinderError {
+	return &BinderError{
+		Index:   index,
+		Value:   value,
+		Reason:  reason.Reason,
+		Message: reason.Message,
+		Err:     err,
+	}
+}
+
+func indexBinderError(err error, index int) error {
+	var binderErr *BinderError
+	if !errors.As(err, &binderErr) {
+		return err
+	}
+
+	indexed := *binderErr
+	indexed.Index = index
+
+	return &indexed
+}
+
+func isBindingError(err error) bool {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		for _, err := range errs {
+			if !isBindingError(err) {
+				return false
+			}
+		}
+
+		return len(errs) > 0
+	}
+
+	var binderErr *BinderError
+	var bindingErr *echo.BindingError
+
+	return errors.As(err, &binderErr) || errors.As(err, &bindingErr)
+}
+
+func NewBindingError(location string, field string, errs ...error) *Error {
+	return ErrBadRequest.WithDetails(ErrorDetailsOf(location, field, errs...)...)
+}
+
+func ErrorDetailsOf(location string, field string, errs ...error) []ErrorDetail {
+	details := []ErrorDetail{}
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			details = append(details, ErrorDetailsOf(location, field, joined.Unwrap()...)...)
+			continue
+		}
+
+		var binderErr *BinderError
+		if errors.As(err, &binderErr) {
+			name := field
+			if binderErr.Index >= 0 {
+				name = fmt.Sprintf("%s[%d]", field, binderErr.Index)
+			}
+
+			details = append(details, ErrorDetail{
+				Field:    name,
+				Location: location,
+				Reason:   binderErr.Reason,
+				Message:  binderErr.Message,
+			})
+
+			continue
+		}
+
+		var bindingErr *echo.BindingError
+		if errors.As(err, &bindingErr) {
+			name := bindingErr.Field
+			if name == "" {
+				name = field
+			}
+
+			details = append(details, ErrorDetail{
+				Field:    name,
+				Location: location,
+				Reason:   ErrInvalidValue.Reason,
+				Message:  fmt.Sprint(bindingErr.Message),
+			})
+
+			continue
+		}
+
+		var e *Error
+		if errors.As(err, &e) {
+			if len(e.Details) > 0 {
+				details = append(details, e.Details...)
+				continue
+			}
+
+			details = append(details, ErrorDetail{
+				Field:    field,
+				Location: location,
+				Reason:   e.Reason,
+				Message:  e.Message,
+			})
+
+			continue
+		}
+
+		details = append(details, ErrorDetail{
+			Field:    field,
+			Location: location,
+			Reason:   ErrInvalidValue.Reason,
+			Message:  err.Error(),
+		})
+	}
+
+	return details
+}
@@ -0,0 +1,75 @@
+package dhasar
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func TestHTTPErrorHandlerRendersUUIDSliceElementDetails(t *testing.T) {
+	values := []string{uuid.NewString(), "not-a-uuid"}
+
+	cases := map[string]struct {
+		err   func(c echo.Context) error
+		field string
+	}{
+		"joined": {
+			err: func(echo.Context) error {
+				ids := make([]uuid.UUID, len(values))
+				return errors.Join(MustUUIDSliceBinder(ids)(values)...)
+			},
+			field: "[1]",
+		},
+		"echo binder": {
+			err: func(c echo.Context) error {
+				ids := []uuid.UUID{}
+				return echo.QueryParamsBinder(c).CustomFunc("id", UUIDSlicePtrBinder(&ids)).BindError()
+			},
+			field: "[1]",
+		},
+		"named": {
+			err: func(echo.Context) error {
+				ids := make([]uuid.UUID, len(values))
+				return NewBindingError(ErrorLocationQuery, "id", MustUUIDSliceBinder(ids)(values)...)
+			},
+			field: "id[1]",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?id="+values[0]+"&id="+values[1], nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			server := &HTTPServer{ErrorRenderer: EnvelopeErrorRenderer}
+			server.HTTPErrorHandler(tc.err(c), c)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d", rec.Code)
+			}
+
+			body := struct {
+				Error Error `json:"error"`
+			}{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(body.Error.Details) != 1 {
+				t.Fatalf("expected 1 detail, got %+v", body.Error.Details)
+			}
+
+			detail := body.Error.Details[0]
+			if detail.Field != tc.field || detail.Reason != ErrInvalidUUID.Reason {
+				t.Fatalf("unexpected detail %+v", detail)
+			}
+		})
+	}
+}
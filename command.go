@@ -0,0 +1,342 @@
+package dhasar
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/fikrirnurhidayat/x/logger"
+)
+
+type Command struct {
+	Name       string
+	Usage      string
+	Short      string
+	Long       string
+	Flags      func(*flag.FlagSet)
+	Run        func(*CommandContext) error
+	SkipVerify bool
+	parent     *Command
+	commands   map[string]*Command
+}
+
+type CommandContext struct {
+	context.Context
+	Command    *Command
+	Args       []string
+	Flags      *flag.FlagSet
+	Dependency *RootDependency
+	Container  *Container
+	Stdout     io.Writer
+	Stderr     io.Writer
+}
+
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+func (c *Command) AddCommand(commands ...*Command) error {
+	if c.commands == nil {
+		c.commands = make(map[string]*Command)
+	}
+
+	for _, command := range commands {
+		if _, ok := c.commands[command.Name]; ok {
+			return fmt.Errorf("command %q is already registered under %q", command.Name, c.Path())
+		}
+
+		command.parent = c
+		c.commands[command.Name] = command
+	}
+
+	return nil
+}
+
+func (c *Command) Commands() []*Command {
+	commands := make([]*Command, 0, len(c.commands))
+	for _, command := range c.commands {
+		commands = append(commands, command)
+	}
+
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+
+	return commands
+}
+
+func (c *Command) Path() string {
+	if c.parent == nil {
+		return c.Name
+	}
+
+	return fmt.Sprintf("%s %s", c.parent.Path(), c.Name)
+}
+
+func (c *Command) flagSet(output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(c.Path(), flag.ContinueOnError)
+	fs.SetOutput(output)
+	if c.Flags != nil {
+		c.Flags(fs)
+	}
+
+	fs.Usage = func() {
+		c.PrintHelp(output, fs)
+	}
+
+	return fs
+}
+
+func (c *Command) PrintHelp(w io.Writer, fs *flag.FlagSet) {
+	if c.Long != "" {
+		fmt.Fprintf(w, "%s\n\n", c.Long)
+	} else if c.Short != "" {
+		fmt.Fprintf(w, "%s\n\n", c.Short)
+	}
+
+	usage := c.Usage
+	if usage == "" {
+		usage = c.Path()
+		if len(c.commands) > 0 {
+			usage += " <command>"
+		}
+
+		usage += " [flags]"
+	}
+
+	fmt.Fprintf(w, "Usage:\n  %s\n", usage)
+
+	if len(c.commands) > 0 {
+		fmt.Fprintf(w, "\nCommands:\n")
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		for _, command := range c.Commands() {
+			fmt.Fprintf(tw, "  %s\t%s\n", command.Name, command.Short)
+		}
+		tw.Flush()
+	}
+
+	hasFlags := false
+	fs.VisitAll(func(*flag.Flag) {
+		hasFlags = true
+	})
+
+	if hasFlags {
+		fmt.Fprintf(w, "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+}
+
+type CLI struct {
+	Root       *Command
+	Container  *Container
+	Dependency *RootDependency
+	Stdout     io.Writer
+	Stderr     io.Writer
+	modules    []any
+}
+
+func (cli *CLI) Execute(ctx context.Context, args []string) int {
+	command := cli.Root
+
+	for {
+		fs := command.flagSet(cli.Stderr)
+		if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		} else if err != nil {
+			return ExitUsage
+		}
+
+		args = fs.Args()
+		if len(args) > 0 {
+			if args[0] == "help" && command.commands["help"] == nil {
+				command.PrintHelp(cli.Stdout, fs)
+				return ExitOK
+			}
+
+			if child, ok := command.commands[args[0]]; ok {
+				command = child
+				args = args[1:]
+				continue
+			}
+		}
+
+		if command.Run == nil {
+			if len(args) > 0 {
+				fmt.Fprintf(cli.Stderr, "unknown command %q for %q\n\n", args[0], command.Path())
+			}
+
+			command.PrintHelp(cli.Stderr, fs)
+			return ExitUsage
+		}
+
+		err := cli.run(command, &CommandContext{
+			Context:    ctx,
+			Command:    command,
+			Args:       args,
+			Flags:      fs,
+			Dependency: cli.Dependency,
+			Container:  cli.Container,
+			Stdout:     cli.Stdout,
+			Stderr:     cli.Stderr,
+		})
+		if err == nil {
+			return ExitOK
+		}
+
+		var exitErr *ExitError
+		if errors.As(err, &exitErr) {
+			if exitErr.Err != nil {
+				fmt.Fprintf(cli.Stderr, "%s: %s\n", command.Path(), exitErr.Err.Error())
+			}
+
+			return exitErr.Code
+		}
+
+		fmt.Fprintf(cli.Stderr, "%s: %s\n", command.Path(), err.Error())
+		return ExitFailure
+	}
+}
+
+func (cli *CLI) run(command *Command, c *CommandContext) error {
+	if !command.SkipVerify {
+		if err := VerifyModules(cli.Container, cli.modules...); err != nil {
+			return err
+		}
+	}
+
+	return command.Run(c)
+}
+
+func NewVersionCommand(version string) *Command {
+	return &Command{
+		Name:       "version",
+		Short:      "Print the version",
+		SkipVerify: true,
+		Run: func(c *CommandContext) error {
+			_, err := fmt.Fprintln(c.Stdout, version)
+			return err
+		},
+	}
+}
+
+func NewServeCommand(app *App) *Command {
+	return &Command{
+		Name:       "serve",
+		Short:      "Start the HTTP server",
+		SkipVerify: true,
+		Run: func(c *CommandContext) error {
+			if code := app.Run(c); code != ExitOK {
+				return &ExitError{Code: code}
+			}
+
+			return nil
+		},
+	}
+}
+
+type CLIOption struct {
+	Name       string
+	Short      string
+	Version    string
+	Container  *Container
+	Logger     logger.Logger
+	Dependency *RootDependency
+	Modules    CLIModules
+	App        *App
+	Stdout     io.Writer
+	Stderr     io.Writer
+}
+
+func NewCLI(opt *CLIOption) (*CLI, error) {
+	cli := &CLI{
+		Root: &Command{
+			Name:  opt.Name,
+			Short: opt.Short,
+		},
+		Container:  opt.Container,
+		Dependency: opt.Dependency,
+		Stdout:     opt.Stdout,
+		Stderr:     opt.Stderr,
+	}
+
+	if cli.Container == nil {
+		cli.Container = NewContainer()
+	}
+
+	if cli.Dependency == nil && opt.Logger == nil {
+		return nil, errors.New("cli root dependency or logger is required")
+	}
+
+	if cli.Dependency == nil {
+		dependency, err := NewRootDependency(&RootDependencyOption{
+			Logger:    opt.Logger,
+			Container: cli.Container,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cli.Dependency = dependency
+	}
+
+	if cli.Stdout == nil {
+		cli.Stdout = os.Stdout
+	}
+
+	if cli.Stderr == nil {
+		cli.Stderr = os.Stderr
+	}
+
+	if err := cli.Root.AddCommand(NewVersionCommand(opt.Version)); err != nil {
+		return nil, err
+	}
+
+	if opt.App != nil {
+		if err := cli.Root.AddCommand(NewServeCommand(opt.App)); err != nil {
+			return nil, err
+		}
+	}
+
+	modules, err := opt.Modules.Sorted()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, module := range modules {
+		module.Wire(cli.Dependency)
+		if err := module.WireCommand(cli.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	cli.modules = anyOf(modules)
+
+	return cli, nil
+}
+
+func anyOf[T any](values []T) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,250 @@
+package dhasar
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type ServerConfig struct {
+	Port            uint          `mapstructure:"port"`
+	DrainPeriod     time.Duration `mapstructure:"drain_period"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+}
+
+type Config struct {
+	ConfigView `mapstructure:"-"`
+	Server     ServerConfig                   `mapstructure:"server"`
+	Postgres   *PostgresDatabaseAdapterOption `mapstructure:"postgres"`
+	SQLite     *SQLiteDatabaseAdapterOption   `mapstructure:"sqlite"`
+	Redis      *RedisDatabaseAdapterOption    `mapstructure:"redis"`
+	source     *viper.Viper
+}
+
+type ConfigOption struct {
+	File      string
+	EnvPrefix string
+	Flags     *flag.FlagSet
+	Defaults  map[string]any
+	Viper     *viper.Viper
+}
+
+type ConfigFieldError struct {
+	Key     string
+	Message string
+}
+
+func (e *ConfigFieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+type ConfigError struct {
+	Errors []error
+}
+
+func (e *ConfigError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "config is not valid, %d error(s):", len(e.Errors))
+	for _, err := range e.Errors {
+		fmt.Fprintf(&b, "\n  - %s", err.Error())
+	}
+
+	return b.String()
+}
+
+func (e *ConfigError) Unwrap() []error {
+	return e.Errors
+}
+
+var DefaultConfig = map[string]any{
+	"server.port":             8080,
+	"server.drain_period":     "0s",
+	"server.shutdown_timeout": "10s",
+}
+
+func (c *Config) Validate() error {
+	errs := []error{}
+
+	if c.Server.Port == 0 || c.Server.Port > 65535 {
+		errs = append(errs, &ConfigFieldError{"server.port", "must be between 1 and 65535"})
+	}
+
+	if c.Server.DrainPeriod < 0 {
+		errs = append(errs, &ConfigFieldError{"server.drain_period", "must not be negative"})
+	}
+
+	if c.Server.ShutdownTimeout < 0 {
+		errs = append(errs, &ConfigFieldError{"server.shutdown_timeout", "must not be negative"})
+	}
+
+	if c.Postgres != nil {
+		errs = append(errs, c.Postgres.Validate("postgres")...)
+	}
+
+	if c.SQLite != nil {
+		errs = append(errs, c.SQLite.Validate("sqlite")...)
+	}
+
+	if c.Redis != nil {
+		errs = append(errs, c.Redis.Validate("redis")...)
+	}
+
+	if len(errs) > 0 {
+		return &ConfigError{Errors: errs}
+	}
+
+	return nil
+}
+
+func ConfigSection[T any](c *Config, key string) (T, error) {
+	var section T
+
+	if err := c.Unmarshal(key, &section); err != nil {
+		return section, &ConfigError{Errors: []error{&ConfigFieldError{key, err.Error()}}}
+	}
+
+	if v, ok := any(&section).(interface{ Validate(prefix string) []error }); ok {
+		if errs := v.Validate(key); len(errs) > 0 {
+			return section, &ConfigError{Errors: errs}
+		}
+	}
+
+	return section, nil
+}
+
+type configFlagValue struct {
+	flag *flag.Flag
+}
+
+func (f configFlagValue) HasChanged() bool    { return true }
+func (f configFlagValue) Name() string        { return f.flag.Name }
+func (f configFlagValue) ValueString() string { return f.flag.Value.String() }
+func (f configFlagValue) ValueType() string   { return "string" }
+
+type configFlagSet struct {
+	fs *flag.FlagSet
+}
+
+func (s configFlagSet) VisitAll(fn func(viper.FlagValue)) {
+	s.fs.Visit(func(f *flag.Flag) {
+		fn(configFlagValue{f})
+	})
+}
+
+func bindConfigEnv(v *viper.Viper, prefix string, t reflect.Type) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("mapstructure")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		key := tag
+		if prefix != "" {
+			key = prefix + "." + tag
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}) {
+			if err := bindConfigEnv(v, key, fieldType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := v.BindEnv(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func LoadConfig(opt *ConfigOption) (*Config, error) {
+	v := opt.Viper
+	if v == nil {
+		v = viper.GetViper()
+	}
+
+	for key, value := range DefaultConfig {
+		v.SetDefault(key, value)
+	}
+
+	for key, value := range opt.Defaults {
+		v.SetDefault(key, value)
+	}
+
+	if opt.EnvPrefix != "" {
+		v.SetEnvPrefix(opt.EnvPrefix)
+	}
+
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if err := bindConfigEnv(v, "", reflect.TypeOf(Config{})); err != nil {
+		return nil, err
+	}
+
+	if opt.Flags != nil {
+		if err := v.BindFlagValues(configFlagSet{opt.Flags}); err != nil {
+			return nil, err
+		}
+	}
+
+	if opt.File != "" {
+		v.SetConfigFile(opt.File)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, &ConfigError{Errors: []error{err}}
+		}
+	}
+
+	return decodeConfig(v)
+}
+
+func decodeConfig(v *viper.Viper) (*Config, error) {
+	return decodeSettings(v.AllSettings(), v)
+}
+
+func decodeSettings(settings map[string]any, v *viper.Viper) (*Config, error) {
+	snapshot := viper.New()
+	if err := snapshot.MergeConfigMap(settings); err != nil {
+		return nil, &ConfigError{Errors: []error{err}}
+	}
+
+	config := &Config{
+		ConfigView: NewConfigView(snapshot),
+		source:     v,
+	}
+
+	if err := snapshot.Unmarshal(config); err != nil {
+		return nil, &ConfigError{Errors: []error{err}}
+	}
+
+	if config.Postgres != nil && config.Postgres.SSLMode == "" {
+		config.Postgres.SSLMode = "disable"
+	}
+
+	if config.Redis != nil && config.Redis.Network == "" {
+		config.Redis.Network = "tcp"
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
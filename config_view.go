@@ -0,0 +1,83 @@
+package dhasar
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type ConfigView interface {
+	IsSet(key string) bool
+	Get(key string) any
+	GetString(key string) string
+	GetInt(key string) int
+	GetUint(key string) uint
+	GetBool(key string) bool
+	GetDuration(key string) time.Duration
+	GetStringSlice(key string) []string
+	Sub(key string) ConfigView
+	Unmarshal(key string, target any) error
+}
+
+type viperConfigView struct {
+	v *viper.Viper
+}
+
+func (c *viperConfigView) IsSet(key string) bool {
+	return c.v.IsSet(key)
+}
+
+func (c *viperConfigView) Get(key string) any {
+	return c.v.Get(key)
+}
+
+func (c *viperConfigView) GetString(key string) string {
+	return c.v.GetString(key)
+}
+
+func (c *viperConfigView) GetInt(key string) int {
+	return c.v.GetInt(key)
+}
+
+func (c *viperConfigView) GetUint(key string) uint {
+	return c.v.GetUint(key)
+}
+
+func (c *viperConfigView) GetBool(key string) bool {
+	return c.v.GetBool(key)
+}
+
+func (c *viperConfigView) GetDuration(key string) time.Duration {
+	return c.v.GetDuration(key)
+}
+
+func (c *viperConfigView) GetStringSlice(key string) []string {
+	return c.v.GetStringSlice(key)
+}
+
+func (c *viperConfigView) Sub(key string) ConfigView {
+	sub := c.v.Sub(key)
+	if sub == nil {
+		sub = viper.New()
+	}
+
+	return NewConfigView(sub)
+}
+
+func (c *viperConfigView) Unmarshal(key string, target any) error {
+	if key == "" {
+		return c.v.Unmarshal(target)
+	}
+
+	return c.v.UnmarshalKey(key, target)
+}
+
+func NewConfigView(v *viper.Viper) ConfigView {
+	if v == nil {
+		v = viper.GetViper()
+	}
+
+	return &viperConfigView{
+		v: v,
+	}
+}
@@ -0,0 +1,156 @@
+package dhasar
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fikrirnurhidayat/x/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+var ConfigReloadDelay = 100 * time.Millisecond
+
+// ConfigWatcher owns the live configuration. App.Config and
+// RootDependency.Config keep the snapshot taken at startup; only Current
+// and subscribers observe reloads.
+type ConfigWatcher struct {
+	mu          sync.RWMutex
+	reloadMu    sync.Mutex
+	current     *Config
+	timer       *time.Timer
+	generation  uint64
+	applied     uint64
+	logger      logger.Logger
+	subscribers map[int]func(old, new *Config)
+	nextID      int
+}
+
+func (w *ConfigWatcher) Current() *Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.current
+}
+
+func (w *ConfigWatcher) Subscribe(fn func(old, new *Config)) func() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	id := w.nextID
+	w.nextID++
+	w.subscribers[id] = fn
+
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subscribers, id)
+	}
+}
+
+func (w *ConfigWatcher) Start() {
+	source := w.Current().source
+	source.OnConfigChange(func(e fsnotify.Event) {
+		w.logger.Debug("config/CHANGED", logger.String("file", e.Name), logger.String("op", e.Op.String()))
+
+		settings := source.AllSettings()
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		if w.timer != nil {
+			w.timer.Stop()
+		}
+
+		w.generation++
+		generation := w.generation
+		w.timer = time.AfterFunc(ConfigReloadDelay, func() {
+			w.apply(generation, settings)
+		})
+	})
+	source.WatchConfig()
+}
+
+func (w *ConfigWatcher) Reload() error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	w.mu.Lock()
+	w.generation++
+	generation := w.generation
+	w.mu.Unlock()
+
+	source := w.Current().source
+	if err := source.ReadInConfig(); err != nil {
+		w.logger.Error("config/RELOAD", logger.String("error", err.Error()))
+		return &ConfigError{Errors: []error{err}}
+	}
+
+	return w.reload(generation, source.AllSettings())
+}
+
+func (w *ConfigWatcher) apply(generation uint64, settings map[string]any) error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	return w.reload(generation, settings)
+}
+
+func (w *ConfigWatcher) reload(generation uint64, settings map[string]any) error {
+	if generation <= w.applied {
+		return nil
+	}
+
+	w.applied = generation
+
+	old := w.Current()
+
+	next, err := decodeSettings(settings, old.source)
+	if err != nil {
+		w.logger.Error("config/RELOAD", logger.String("error", err.Error()))
+		return err
+	}
+
+	w.mu.Lock()
+	w.current = next
+	ids := make([]int, 0, len(w.subscribers))
+	for id := range w.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subscribers := make([]func(old, new *Config), 0, len(ids))
+	for _, id := range ids {
+		subscribers = append(subscribers, w.subscribers[id])
+	}
+	w.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(old, next)
+	}
+
+	w.logger.Info("config/RELOAD", logger.String("status", "OK!"))
+
+	return nil
+}
+
+func SubscribeConfig[T any](w *ConfigWatcher, selector func(*Config) T, fn func(old, new T)) func() {
+	return w.Subscribe(func(old, new *Config) {
+		oldValue, newValue := selector(old), selector(new)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			fn(oldValue, newValue)
+		}
+	})
+}
+
+func NewConfigWatcher(config *Config, logger logger.Logger) (*ConfigWatcher, error) {
+	if config.source == nil {
+		return nil, errors.New("config watcher requires a config created by LoadConfig")
+	}
+
+	return &ConfigWatcher{
+		current:     config,
+		logger:      logger,
+		subscribers: make(map[int]func(old, new *Config)),
+	}, nil
+}
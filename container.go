@@ -1,47 +1,592 @@
 package dhasar
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
+type Lifetime int
+
+const (
+	Singleton Lifetime = iota
+	Transient
+	Scoped
+)
+
+func (l Lifetime) String() string {
+	switch l {
+	case Singleton:
+		return "singleton"
+	case Transient:
+		return "transient"
+	case Scoped:
+		return "scoped"
+	}
+
+	return fmt.Sprintf("lifetime(%d)", int(l))
+}
+
+type Factory[T any] func(*Container) (T, error)
+
+type Decorator func(any) (any, error)
+
+type provider struct {
+	mu       sync.Mutex
+	lifetime Lifetime
+	factory  Factory[any]
+	external bool
+	resolved atomic.Bool
+	instance any
+	owner    *resolution
+}
+
+type resolution struct {
+	waiting    *provider
+	waitingKey any
+}
+
+var resolutions sync.Mutex
+
+func newInstanceProvider(instance any) *provider {
+	return &provider{
+		lifetime: Singleton,
+		external: true,
+		factory: func(*Container) (any, error) {
+			return instance, nil
+		},
+	}
+}
+
+func (p *provider) create(c *Container, decorators []Decorator) (any, error) {
+	instance, err := p.factory(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decorate := range decorators {
+		if instance, err = decorate(instance); err != nil {
+			return nil, err
+		}
+	}
+
+	return instance, nil
+}
+
+func (p *provider) get(c *Container, decorators []Decorator) (any, bool, error) {
+	if p.lifetime == Transient {
+		instance, err := p.create(c, decorators)
+		return instance, false, err
+	}
+
+	if err := p.wait(c); err != nil {
+		return nil, false, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	resolutions.Lock()
+	c.chain.waiting, c.chain.waitingKey = nil, nil
+	resolutions.Unlock()
+
+	if p.resolved.Load() {
+		return p.instance, false, nil
+	}
+
+	resolutions.Lock()
+	p.owner = c.chain
+	resolutions.Unlock()
+
+	defer func() {
+		resolutions.Lock()
+		p.owner = nil
+		resolutions.Unlock()
+	}()
+
+	instance, err := p.create(c, decorators)
+	if err != nil {
+		return nil, false, err
+	}
+
+	p.instance = instance
+	p.resolved.Store(true)
+
+	return instance, !p.external, nil
+}
+
+func (p *provider) wait(c *Container) error {
+	resolutions.Lock()
+	defer resolutions.Unlock()
+
+	path := append([]any{}, c.path...)
+	for owner := p.owner; owner != nil && owner.waiting != nil; owner = owner.waiting.owner {
+		path = append(path, owner.waitingKey)
+		if owner.waiting.owner == c.chain {
+			return fmt.Errorf("%w: %s", ErrDependencyCycle, formatPath(path))
+		}
+	}
+
+	c.chain.waiting, c.chain.waitingKey = p, c.path[len(c.path)-1]
+
+	return nil
+}
+
+type closer struct {
+	key   any
+	name  string
+	close func(context.Context) error
+}
+
+type checker struct {
+	key     any
+	name    string
+	checker HealthChecker
+}
+
+type closerKey struct {
+	name string
+}
+
+type typeKey struct {
+	typ       reflect.Type
+	qualifier string
+}
+
+func (k typeKey) String() string {
+	if k.qualifier == "" {
+		return k.typ.String()
+	}
+
+	return fmt.Sprintf("%s#%s", k.typ.String(), k.qualifier)
+}
+
+func keyOf[T any](qualifier ...string) typeKey {
+	key := typeKey{
+		typ: typeOf[T](),
+	}
+
+	if len(qualifier) > 0 {
+		key.qualifier = qualifier[0]
+	}
+
+	return key
+}
+
 type Container struct {
-	mu           sync.RWMutex
-	dependencies map[string]interface{}
+	mu         sync.RWMutex
+	providers  map[any]*provider
+	edges      map[DependencyEdge]struct{}
+	scoped     map[any]*provider
+	decorators map[any][]Decorator
+	closers    []closer
+	checkers   []checker
+	parent     *Container
+	origin     *Container
+	path       []any
+	chain      *resolution
 }
 
 func NewContainer() *Container {
 	return &Container{
-		dependencies: make(map[string]interface{}),
+		providers:  make(map[any]*provider),
+		edges:      make(map[DependencyEdge]struct{}),
+		scoped:     make(map[any]*provider),
+		decorators: make(map[any][]Decorator),
 	}
 }
 
+func (c *Container) Scope() *Container {
+	scope := NewContainer()
+	scope.parent = c.self()
+
+	return scope
+}
+
 func (c *Container) Register(name string, dependency interface{}) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.dependencies[name] = dependency
+	c.register(name, newInstanceProvider(dependency))
+	c.track(name, dependency)
+}
+
+func (c *Container) RegisterCloser(name string, fn func(context.Context) error) {
+	c.setCloser(closer{
+		key:   closerKey{name},
+		name:  name,
+		close: fn,
+	})
+}
+
+func (c *Container) setCloser(cl closer) {
+	root := c.self()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	for i := range root.closers {
+		if root.closers[i].key == cl.key {
+			root.closers[i] = cl
+			return
+		}
+	}
+
+	root.closers = append(root.closers, cl)
+}
+
+func (c *Container) Close(ctx context.Context) error {
+	root := c.self()
+	root.mu.Lock()
+	closers := root.closers
+	root.closers = nil
+	root.mu.Unlock()
+
+	errs := []error{}
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("dependency %s not closed: %w", closers[i].name, err))
+			continue
+		}
+
+		if err := closeWithContext(ctx, closers[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Container) RegisterFactory(name string, lifetime Lifetime, factory Factory[any]) {
+	c.register(name, &provider{
+		lifetime: lifetime,
+		factory:  factory,
+	})
 }
 
 func (c *Container) Resolve(name string) (interface{}, error) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	dependency, ok := c.dependencies[name]
+	return c.resolve(name)
+}
+
+func (c *Container) self() *Container {
+	if c.origin != nil {
+		return c.origin
+	}
+
+	return c
+}
+
+func (c *Container) trace(origin *Container, key any) *Container {
+	path := make([]any, 0, len(c.path)+1)
+	path = append(path, c.path...)
+	path = append(path, key)
+
+	chain := c.chain
+	if chain == nil {
+		chain = &resolution{}
+	}
+
+	return &Container{
+		origin: origin,
+		path:   path,
+		chain:  chain,
+	}
+}
+
+func (c *Container) lookup(key any) (*Container, *provider, bool) {
+	for current := c.self(); current != nil; current = current.parent {
+		current.mu.RLock()
+		p, ok := current.providers[key]
+		current.mu.RUnlock()
+		if ok {
+			return current, p, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func (c *Container) scopedProvider(key any, template *provider) *provider {
+	root := c.self()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if p, ok := root.scoped[key]; ok {
+		return p
+	}
+
+	p := &provider{
+		lifetime: Scoped,
+		factory:  template.factory,
+	}
+	root.scoped[key] = p
+
+	return p
+}
+
+func (c *Container) decorate(key any, decorator Decorator) error {
+	root := c.self()
+
+	if owner, p, ok := c.lookup(key); ok && p.lifetime == Singleton {
+		if owner != root {
+			return fmt.Errorf("%w: cannot decorate %v", ErrDependencyInParent, key)
+		}
+
+		if p.resolved.Load() {
+			return fmt.Errorf("%w: cannot decorate %v", ErrDependencyResolved, key)
+		}
+	}
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if p, ok := root.scoped[key]; ok && p.resolved.Load() {
+		return fmt.Errorf("%w: cannot decorate %v", ErrDependencyResolved, key)
+	}
+
+	root.decorators[key] = append(root.decorators[key], decorator)
+
+	return nil
+}
+
+func (c *Container) decoratorsOf(key any) []Decorator {
+	chain := []*Container{}
+	for current := c.self(); current != nil; current = current.parent {
+		chain = append(chain, current)
+	}
+
+	decorators := []Decorator{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		chain[i].mu.RLock()
+		decorators = append(decorators, chain[i].decorators[key]...)
+		chain[i].mu.RUnlock()
+	}
+
+	return decorators
+}
+
+func (c *Container) HealthCheckers() map[string]HealthChecker {
+	root := c.self()
+	root.mu.RLock()
+	defer root.mu.RUnlock()
+
+	checkers := make(map[string]HealthChecker, len(root.checkers))
+	for _, ch := range root.checkers {
+		checkers[ch.name] = ch.checker
+	}
+
+	return checkers
+}
+
+func (c *Container) setChecker(ch checker) {
+	root := c.self()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	for i := range root.checkers {
+		if root.checkers[i].key == ch.key {
+			root.checkers[i] = ch
+			return
+		}
+	}
+
+	root.checkers = append(root.checkers, ch)
+}
+
+func (c *Container) track(key any, dependency any) {
+	if v, ok := dependency.(HealthChecker); ok {
+		c.setChecker(checker{
+			key:     key,
+			name:    fmt.Sprint(key),
+			checker: v,
+		})
+	}
+
+	if v, ok := dependency.(io.Closer); ok {
+		c.setCloser(closer{
+			key:  key,
+			name: fmt.Sprint(key),
+			close: func(context.Context) error {
+				return v.Close()
+			},
+		})
+	}
+}
+
+func (c *Container) register(key any, p *provider) {
+	root := c.self()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if old, ok := root.providers[key]; ok && old.external {
+		root.closers = slices.DeleteFunc(root.closers, func(cl closer) bool {
+			return cl.key == key
+		})
+		root.checkers = slices.DeleteFunc(root.checkers, func(ch checker) bool {
+			return ch.key == key
+		})
+	}
+
+	root.providers[key] = p
+}
+
+func (c *Container) resolve(key any) (any, error) {
+	root := c.self()
+
+	owner, p, ok := c.lookup(key)
+
+	root.mu.Lock()
+	if len(c.path) > 0 {
+		root.edges[DependencyEdge{
+			From: fmt.Sprint(c.path[len(c.path)-1]),
+			To:   fmt.Sprint(key),
+		}] = struct{}{}
+	}
+	root.mu.Unlock()
+
+	for i, k := range c.path {
+		if k == key {
+			return nil, fmt.Errorf("%w: %s", ErrDependencyCycle, formatPath(append(c.path[i:], key)))
+		}
+	}
+
 	if !ok {
-		return nil, fmt.Errorf("dependency %s not found", name)
+		return nil, fmt.Errorf("%w: %v", ErrDependencyNotFound, key)
 	}
+
+	switch p.lifetime {
+	case Transient:
+		owner = root
+	case Scoped:
+		owner = root
+		p = root.scopedProvider(key, p)
+	}
+
+	dependency, created, err := p.get(c.trace(owner, key), owner.decoratorsOf(key))
+	if errors.Is(err, ErrDependencyCycle) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("dependency %v failed to resolve: %w", key, err)
+	}
+
+	if created {
+		owner.track(key, dependency)
+	}
+
 	return dependency, nil
 }
 
+func Provide[T any](c *Container, lifetime Lifetime, factory Factory[T], qualifier ...string) {
+	c.register(keyOf[T](qualifier...), &provider{
+		lifetime: lifetime,
+		factory: func(c *Container) (any, error) {
+			return factory(c)
+		},
+	})
+}
+
+func Supply[T any](c *Container, dependency T, qualifier ...string) {
+	key := keyOf[T](qualifier...)
+	c.register(key, newInstanceProvider(dependency))
+	c.track(key, dependency)
+}
+
+func Resolve[T any](c *Container, qualifier ...string) (T, error) {
+	return resolveAs[T](c, keyOf[T](qualifier...))
+}
+
+func MustResolve[T any](c *Container, qualifier ...string) T {
+	dep, err := Resolve[T](c, qualifier...)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return dep
+}
+
+func ProvideNamed[T any](c *Container, name string, lifetime Lifetime, factory Factory[T]) {
+	c.RegisterFactory(name, lifetime, func(c *Container) (any, error) {
+		return factory(c)
+	})
+}
+
+func ResolveNamed[T any](c *Container, name string) (T, error) {
+	return resolveAs[T](c, name)
+}
+
 func GetDep[T any](c *Container, name string) T {
-	dependency, err := c.Resolve(name)
+	dep, err := ResolveNamed[T](c, name)
 	if err != nil {
-		panic(fmt.Sprintf("%s is not resolved.", name))
+		panic(fmt.Sprintf("%s is not resolved: %s", name, err.Error()))
+	}
+
+	return dep
+}
+
+func Decorate[T any](c *Container, fn func(T) T, qualifier ...string) error {
+	return c.decorate(keyOf[T](qualifier...), decoratorOf(fn))
+}
+
+func DecorateNamed[T any](c *Container, name string, fn func(T) T) error {
+	return c.decorate(name, decoratorOf(fn))
+}
+
+func decoratorOf[T any](fn func(T) T) Decorator {
+	return func(dependency any) (any, error) {
+		dep, ok := dependency.(T)
+		if !ok {
+			return nil, fmt.Errorf("%w: cannot decorate %T as %s", ErrDependencyMismatch, dependency, typeOf[T]())
+		}
+
+		return fn(dep), nil
+	}
+}
+
+func resolveAs[T any](c *Container, key any) (T, error) {
+	var noDep T
+
+	dependency, err := c.resolve(key)
+	if err != nil {
+		return noDep, err
 	}
 
 	dep, ok := dependency.(T)
 	if !ok {
-		panic(fmt.Sprintf("%s is not resolved.", name))
+		return noDep, fmt.Errorf("%w: %v is %T, not %s", ErrDependencyMismatch, key, dependency, typeOf[T]())
 	}
 
-	return dep
+	return dep, nil
+}
+
+func closeWithContext(ctx context.Context, c closer) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- c.close(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("dependency %s failed to close: %w", c.name, err)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("dependency %s failed to close: %w", c.name, ctx.Err())
+	}
+}
+
+func formatPath(path []any) string {
+	parts := make([]string, 0, len(path))
+	for _, key := range path {
+		parts = append(parts, fmt.Sprint(key))
+	}
+
+	return strings.Join(parts, " -> ")
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
 }
@@ -0,0 +1,12 @@
+package dhasar
+
+import "errors"
+
+var (
+	ErrDependencyNotFound = errors.New("dependency not found")
+	ErrDependencyMismatch = errors.New("dependency type mismatch")
+	ErrDependencyCycle    = errors.New("dependency cycle detected")
+	ErrDependencyResolved = errors.New("dependency already resolved")
+	ErrDependencyInParent = errors.New("dependency is a singleton of a parent container")
+	ErrContainerNotFound  = errors.New("container not found in context")
+)
@@ -0,0 +1,107 @@
+package dhasar
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const InjectTag = "dhasar"
+
+type injection struct {
+	key      any
+	optional bool
+}
+
+func parseInjection(field reflect.StructField) (injection, bool, error) {
+	tag, ok := field.Tag.Lookup(InjectTag)
+	if !ok || tag == "-" {
+		return injection{}, false, nil
+	}
+
+	inj := injection{}
+	qualifier := ""
+	byType := false
+
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		key, value, _ := strings.Cut(part, "=")
+
+		switch key {
+		case "inject":
+			byType = true
+		case "name":
+			inj.key = value
+		case "qualifier":
+			byType = true
+			qualifier = value
+		case "optional":
+			inj.optional = true
+		case "":
+		default:
+			return inj, false, fmt.Errorf("unknown %s tag option %q", InjectTag, key)
+		}
+	}
+
+	if inj.key != nil && byType {
+		return inj, false, fmt.Errorf("%s tag cannot combine name with inject or qualifier", InjectTag)
+	}
+
+	if inj.key == nil {
+		inj.key = typeKey{
+			typ:       field.Type,
+			qualifier: qualifier,
+		}
+	}
+
+	return inj, true, nil
+}
+
+func (c *Container) Populate(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("populate target must be a non-nil pointer to struct, got %T", target)
+	}
+
+	v = v.Elem()
+	t := v.Type()
+	errs := []error{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		inj, ok, err := parseInjection(field)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err))
+			continue
+		} else if !ok {
+			continue
+		}
+
+		if !field.IsExported() {
+			errs = append(errs, fmt.Errorf("%s.%s: field is not exported", t.Name(), field.Name))
+			continue
+		}
+
+		dependency, err := c.resolve(inj.key)
+		if err != nil {
+			if inj.optional && errors.Is(err, ErrDependencyNotFound) {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err))
+			continue
+		}
+
+		value := reflect.ValueOf(dependency)
+		if !value.IsValid() || !value.Type().AssignableTo(field.Type) {
+			errs = append(errs, fmt.Errorf("%s.%s: %w: %v is %T, not %s", t.Name(), field.Name, ErrDependencyMismatch, inj.key, dependency, field.Type))
+			continue
+		}
+
+		v.Field(i).Set(value)
+	}
+
+	return errors.Join(errs...)
+}
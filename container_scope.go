@@ -0,0 +1,52 @@
+package dhasar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ContainerKey struct{}
+
+const ContainerContextKey = "dhasar.container"
+
+func WithContainer(ctx context.Context, c *Container) context.Context {
+	return context.WithValue(ctx, ContainerKey{}, c)
+}
+
+func ContainerFromContext(ctx context.Context) (*Container, bool) {
+	c, ok := ctx.Value(ContainerKey{}).(*Container)
+	return c, ok
+}
+
+func ContainerFromEcho(c echo.Context) (*Container, bool) {
+	container, ok := c.Get(ContainerContextKey).(*Container)
+	if ok {
+		return container, true
+	}
+
+	return ContainerFromContext(c.Request().Context())
+}
+
+func ResolveFromContext[T any](ctx context.Context, qualifier ...string) (T, error) {
+	var noDep T
+
+	c, ok := ContainerFromContext(ctx)
+	if !ok {
+		return noDep, fmt.Errorf("%w: %s", ErrContainerNotFound, typeOf[T]())
+	}
+
+	return Resolve[T](c, qualifier...)
+}
+
+func ResolveFromEcho[T any](c echo.Context, qualifier ...string) (T, error) {
+	var noDep T
+
+	container, ok := ContainerFromEcho(c)
+	if !ok {
+		return noDep, fmt.Errorf("%w: %s", ErrContainerNotFound, typeOf[T]())
+	}
+
+	return Resolve[T](container, qualifier...)
+}
@@ -0,0 +1,247 @@
+package dhasar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type Requirement struct {
+	key      any
+	Type     reflect.Type
+	Source   string
+	Optional bool
+}
+
+func (r Requirement) String() string {
+	return fmt.Sprint(r.key)
+}
+
+type Requirer interface {
+	Requires() []Requirement
+}
+
+func Require[T any](qualifier ...string) Requirement {
+	return Requirement{
+		key:  keyOf[T](qualifier...),
+		Type: typeOf[T](),
+	}
+}
+
+func RequireNamed[T any](name string) Requirement {
+	return Requirement{
+		key:  name,
+		Type: typeOf[T](),
+	}
+}
+
+func RequirementsOf(target any) []Requirement {
+	source := fmt.Sprintf("%T", target)
+	requirements := []Requirement{}
+
+	if v, ok := target.(Requirer); ok {
+		for _, requirement := range v.Requires() {
+			if requirement.Source == "" {
+				requirement.Source = source
+			}
+
+			requirements = append(requirements, requirement)
+		}
+	}
+
+	t := reflect.TypeOf(target)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return requirements
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		inj, ok, err := parseInjection(field)
+		if err != nil || !ok {
+			continue
+		}
+
+		requirements = append(requirements, Requirement{
+			key:      inj.key,
+			Type:     field.Type,
+			Source:   fmt.Sprintf("%s.%s", source, field.Name),
+			Optional: inj.optional,
+		})
+	}
+
+	return requirements
+}
+
+type VerificationProblem string
+
+const (
+	VerificationMissing  VerificationProblem = "MISSING"
+	VerificationMistyped VerificationProblem = "MISTYPED"
+	VerificationFailed   VerificationProblem = "FAILED"
+)
+
+type VerificationIssue struct {
+	Dependency string
+	Source     string
+	Problem    VerificationProblem
+	Err        error
+}
+
+type VerificationReport struct {
+	Issues []VerificationIssue
+}
+
+func (r *VerificationReport) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "container verification failed with %d issue(s):", len(r.Issues))
+	for _, issue := range r.Issues {
+		fmt.Fprintf(&b, "\n  - [%s] %s", issue.Problem, issue.Dependency)
+		if issue.Source != "" {
+			fmt.Fprintf(&b, " (required by %s)", issue.Source)
+		}
+
+		if issue.Err != nil {
+			fmt.Fprintf(&b, ": %s", issue.Err.Error())
+		}
+	}
+
+	return b.String()
+}
+
+func (r *VerificationReport) add(issue VerificationIssue) {
+	r.Issues = append(r.Issues, issue)
+}
+
+func (c *Container) Verify(requirements ...Requirement) error {
+	root := c.self()
+	scope := root.Scope()
+	report := &VerificationReport{}
+
+	root.mu.RLock()
+	keys := make([]any, 0, len(root.providers))
+	for key := range root.providers {
+		keys = append(keys, key)
+	}
+	root.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	built := map[any]any{}
+	failed := map[any]bool{}
+	build := func(key any) (any, error) {
+		if dependency, ok := built[key]; ok {
+			return dependency, nil
+		}
+
+		_, p, _ := scope.lookup(key)
+
+		dependency, err := scope.resolve(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if p != nil && p.lifetime == Transient {
+			scope.track(key, dependency)
+		}
+
+		built[key] = dependency
+
+		return dependency, nil
+	}
+
+	for _, key := range keys {
+		if _, err := build(key); err != nil {
+			failed[key] = true
+			report.add(VerificationIssue{
+				Dependency: fmt.Sprint(key),
+				Problem:    VerificationFailed,
+				Err:        err,
+			})
+		}
+	}
+
+	for _, requirement := range requirements {
+		_, _, ok := root.lookup(requirement.key)
+		if !ok {
+			if !requirement.Optional {
+				report.add(VerificationIssue{
+					Dependency: requirement.String(),
+					Source:     requirement.Source,
+					Problem:    VerificationMissing,
+				})
+			}
+
+			continue
+		}
+
+		if failed[requirement.key] {
+			continue
+		}
+
+		dependency, err := build(requirement.key)
+		if errors.Is(err, ErrDependencyNotFound) {
+			report.add(VerificationIssue{
+				Dependency: requirement.String(),
+				Source:     requirement.Source,
+				Problem:    VerificationMissing,
+				Err:        err,
+			})
+			continue
+		} else if err != nil {
+			failed[requirement.key] = true
+			report.add(VerificationIssue{
+				Dependency: requirement.String(),
+				Source:     requirement.Source,
+				Problem:    VerificationFailed,
+				Err:        err,
+			})
+			continue
+		}
+
+		if requirement.Type == nil {
+			continue
+		}
+
+		if dependency == nil || !reflect.TypeOf(dependency).AssignableTo(requirement.Type) {
+			report.add(VerificationIssue{
+				Dependency: requirement.String(),
+				Source:     requirement.Source,
+				Problem:    VerificationMistyped,
+				Err:        fmt.Errorf("%w: got %T, want %s", ErrDependencyMismatch, dependency, requirement.Type),
+			})
+		}
+	}
+
+	if err := scope.Close(context.Background()); err != nil {
+		report.add(VerificationIssue{
+			Dependency: "scope",
+			Problem:    VerificationFailed,
+			Err:        err,
+		})
+	}
+
+	if len(report.Issues) > 0 {
+		return report
+	}
+
+	return nil
+}
+
+func VerifyModules(c *Container, modules ...any) error {
+	requirements := []Requirement{}
+	for _, module := range modules {
+		requirements = append(requirements, RequirementsOf(module)...)
+	}
+
+	return c.Verify(requirements...)
+}
@@ -0,0 +1,127 @@
+package dhasar
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/fikrirnurhidayat/x/exists"
+	"github.com/labstack/echo/v4"
+)
+
+var DefaultCursorParam = "cursor"
+
+type Cursor struct {
+	Columns  []SortColumn `json:"c"`
+	Values   []any        `json:"v"`
+	Backward bool         `json:"b,omitempty"`
+}
+
+func CursorFrom(sort Specification, primaryKey string, backward bool, value func(column SortColumn) any) Cursor {
+	cursor := Cursor{
+		Backward: backward,
+	}
+
+	for _, arg := range StableSort(sort, primaryKey).Arguments {
+		cursor.Columns = append(cursor.Columns, arg.Column)
+		cursor.Values = append(cursor.Values, value(arg.Column))
+	}
+
+	return cursor
+}
+
+func CursorFromContext(c echo.Context, codec CursorCodec, param string) (Specification, error) {
+	if !exists.String(param) {
+		param = DefaultCursorParam
+	}
+
+	token := c.QueryParam(param)
+	if !exists.String(token) {
+		return nil, nil
+	}
+
+	cursor, err := codec.Decode(token)
+	if err != nil {
+		return nil, ErrInvalidCursor.WithDetails(ErrorDetail{
+			Field:    param,
+			Location: ErrorLocationQuery,
+			Reason:   ErrInvalidCursor.Reason,
+			Message:  ErrInvalidCursor.Message,
+		})
+	}
+
+	return WithCursorSpecs(cursor), nil
+}
+
+type CursorCodec interface {
+	Encode(cursor Cursor) (string, error)
+	Decode(token string) (Cursor, error)
+}
+
+type CursorCodecImpl struct {
+	secret []byte
+}
+
+type CursorCodecOption struct {
+	Secret []byte
+}
+
+func (c *CursorCodecImpl) Encode(cursor Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload)), nil
+}
+
+func (c *CursorCodecImpl) Decode(token string) (Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	cursor := Cursor{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&cursor); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if len(cursor.Columns) != len(cursor.Values) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+func (c *CursorCodecImpl) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+func NewCursorCodec(opt *CursorCodecOption) (CursorCodec, error) {
+	if len(opt.Secret) == 0 {
+		return nil, errors.New("cursor codec secret is required")
+	}
+
+	return &CursorCodecImpl{
+		secret: opt.Secret,
+	}, nil
+}
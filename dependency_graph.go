@@ -0,0 +1,95 @@
+package dhasar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type DependencyNode struct {
+	ID       string `json:"id"`
+	Lifetime string `json:"lifetime"`
+	Resolved bool   `json:"resolved"`
+}
+
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type DependencyGraph struct {
+	Nodes []DependencyNode `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+}
+
+func (g DependencyGraph) DependenciesOf(id string) []string {
+	dependencies := []string{}
+	for _, edge := range g.Edges {
+		if edge.From == id {
+			dependencies = append(dependencies, edge.To)
+		}
+	}
+
+	return dependencies
+}
+
+func (g DependencyGraph) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("\trankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		style := "solid"
+		if !node.Resolved {
+			style = "dashed"
+		}
+
+		fmt.Fprintf(&b, "\t%q [label=%q, style=%s];\n", node.ID, fmt.Sprintf("%s\n(%s)", node.ID, node.Lifetime), style)
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "\t%q -> %q;\n", edge.From, edge.To)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func (c *Container) Graph() DependencyGraph {
+	root := c.self()
+	root.mu.RLock()
+	defer root.mu.RUnlock()
+
+	graph := DependencyGraph{
+		Nodes: make([]DependencyNode, 0, len(root.providers)),
+		Edges: make([]DependencyEdge, 0, len(root.edges)),
+	}
+
+	for key, p := range root.providers {
+		graph.Nodes = append(graph.Nodes, DependencyNode{
+			ID:       fmt.Sprint(key),
+			Lifetime: p.lifetime.String(),
+			Resolved: p.resolved.Load(),
+		})
+	}
+
+	for edge := range root.edges {
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From == graph.Edges[j].From {
+			return graph.Edges[i].To < graph.Edges[j].To
+		}
+
+		return graph.Edges[i].From < graph.Edges[j].From
+	})
+
+	return graph
+}
@@ -1,11 +1,33 @@
 package dhasar
 
+const (
+	ErrorLocationPath   = "path"
+	ErrorLocationQuery  = "query"
+	ErrorLocationHeader = "header"
+	ErrorLocationBody   = "body"
+)
+
+type ErrorDetail struct {
+	Field    string `json:"field,omitempty"`
+	Location string `json:"location,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
 type Error struct {
-	Code    int    `json:"code"`
-	Reason  string `json:"reason"`
-	Message string `json:"message"`
+	Code    int           `json:"code"`
+	Reason  string        `json:"reason"`
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details,omitempty"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
+
+func (e *Error) WithDetails(details ...ErrorDetail) *Error {
+	err := *e
+	err.Details = append(append([]ErrorDetail{}, e.Details...), details...)
+
+	return &err
+}
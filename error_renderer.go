@@ -0,0 +1,93 @@
+package dhasar
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+type ErrorRenderer func(c echo.Context, code int, err *Error) error
+
+func EnvelopeErrorRenderer(c echo.Context, code int, err *Error) error {
+	return c.JSON(code, echo.Map{
+		"error": err,
+	})
+}
+
+type ProblemDetails struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]any
+}
+
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	members := make(map[string]any, len(p.Extensions)+5)
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+
+	members["type"] = p.Type
+	members["title"] = p.Title
+	members["status"] = p.Status
+
+	if p.Detail != "" {
+		members["detail"] = p.Detail
+	}
+
+	if p.Instance != "" {
+		members["instance"] = p.Instance
+	}
+
+	return json.Marshal(members)
+}
+
+func ProblemType(baseURI string, reason string) string {
+	if baseURI == "" {
+		return "about:blank"
+	}
+
+	slug := strings.ToLower(strings.ReplaceAll(reason, "_", "-"))
+
+	return strings.TrimSuffix(baseURI, "/") + "/" + slug
+}
+
+func NewProblemDetails(c echo.Context, baseURI string, code int, err *Error) ProblemDetails {
+	problem := ProblemDetails{
+		Type:     ProblemType(baseURI, err.Reason),
+		Title:    http.StatusText(code),
+		Status:   code,
+		Detail:   err.Message,
+		Instance: c.Request().URL.Path,
+		Extensions: map[string]any{
+			"reason": err.Reason,
+		},
+	}
+
+	if len(err.Details) > 0 {
+		problem.Extensions["details"] = err.Details
+	}
+
+	if requestID := c.Response().Header().Get(echo.HeaderXRequestID); requestID != "" {
+		problem.Extensions["request_id"] = requestID
+	}
+
+	return problem
+}
+
+func ProblemErrorRenderer(baseURI string) ErrorRenderer {
+	return func(c echo.Context, code int, err *Error) error {
+		body, jsonErr := json.Marshal(NewProblemDetails(c, baseURI, code, err))
+		if jsonErr != nil {
+			return jsonErr
+		}
+
+		return c.Blob(code, MIMEApplicationProblemJSON, body)
+	}
+}
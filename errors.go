@@ -21,6 +21,72 @@ var (
 		Template: "Route '%s %s' not found.",
 	}
 
+	ErrInvalidValue = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_VALUE",
+		Message: "Value is not valid.",
+	}
+
+	ErrRequiredValue = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "REQUIRED_VALUE",
+		Message: "Value is required.",
+	}
+
+	ErrInvalidJSON = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_JSON",
+		Message: "Request body is not valid JSON.",
+	}
+
+	ErrInvalidInteger = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_INTEGER",
+		Message: "Value is not a valid integer.",
+	}
+
+	ErrValueOutOfRange = &DynamicError{
+		Code:     http.StatusBadRequest,
+		Reason:   "VALUE_OUT_OF_RANGE",
+		Template: "Value must be between %v and %v.",
+	}
+
+	ErrInvalidBoolean = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_BOOLEAN",
+		Message: "Value is not a valid boolean. Please pass true or false.",
+	}
+
+	ErrInvalidTime = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_TIME",
+		Message: "Value is not a valid RFC3339 time.",
+	}
+
+	ErrInvalidDate = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_DATE",
+		Message: "Value is not a valid date. Please pass YYYY-MM-DD.",
+	}
+
+	ErrInvalidDuration = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_DURATION",
+		Message: "Value is not a valid duration.",
+	}
+
+	ErrInvalidEnum = &DynamicError{
+		Code:     http.StatusBadRequest,
+		Reason:   "INVALID_ENUM",
+		Template: "Value must be one of: %s.",
+	}
+
+	ErrTooManyValues = &DynamicError{
+		Code:     http.StatusBadRequest,
+		Reason:   "TOO_MANY_VALUES",
+		Template: "Value accepts at most %d item(s).",
+	}
+
 	ErrInvalidUUID = &Error{
 		Code:    http.StatusBadRequest,
 		Reason:  "INVALID_UUID",
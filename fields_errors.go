@@ -0,0 +1,17 @@
+package dhasar
+
+import "net/http"
+
+var (
+	ErrInvalidFieldsParams = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_FIELDS_PARAMS",
+		Message: "Fields parameter is not valid. Please pass valid fields parameters.",
+	}
+
+	ErrUnknownField = &DynamicError{
+		Code:     http.StatusBadRequest,
+		Reason:   "UNKNOWN_FIELD",
+		Template: "Field '%s' is not allowed.",
+	}
+)
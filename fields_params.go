@@ -0,0 +1,100 @@
+package dhasar
+
+import (
+	"strings"
+
+	"github.com/fikrirnurhidayat/x/exists"
+	"github.com/labstack/echo/v4"
+)
+
+var DefaultFieldsParam = "fields"
+
+type ProjectionField struct {
+	Name   string
+	Column string
+}
+
+type ProjectionFields []ProjectionField
+
+type FieldsParams struct {
+	Fields   ProjectionFields
+	Param    string
+	Selected []string
+}
+
+func NewFieldsParams(fields ProjectionFields) *FieldsParams {
+	return &FieldsParams{
+		Fields: fields,
+	}
+}
+
+func (f *FieldsParams) field(name string) (ProjectionField, bool) {
+	for _, field := range f.Fields {
+		if field.Name == name {
+			if field.Column == "" {
+				field.Column = field.Name
+			}
+
+			return field, true
+		}
+	}
+
+	return ProjectionField{}, false
+}
+
+func (f *FieldsParams) ParseFromContext(c echo.Context) error {
+	param := f.Param
+	if !exists.String(param) {
+		param = DefaultFieldsParam
+	}
+
+	fieldsStr := c.QueryParam(param)
+	if !exists.String(fieldsStr) {
+		return nil
+	}
+
+	details := []ErrorDetail{}
+	for _, name := range strings.Split(fieldsStr, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := f.field(name); !ok {
+			details = append(details, ErrorDetail{
+				Field:    param,
+				Location: ErrorLocationQuery,
+				Reason:   ErrUnknownField.Reason,
+				Message:  ErrUnknownField.Format(name).Message,
+			})
+
+			continue
+		}
+
+		f.Selected = append(f.Selected, name)
+	}
+
+	if len(details) > 0 {
+		return ErrInvalidFieldsParams.WithDetails(details...)
+	}
+
+	return nil
+}
+
+func (f *FieldsParams) Specs() Specification {
+	if len(f.Selected) == 0 {
+		return nil
+	}
+
+	columns := make([]string, 0, len(f.Selected))
+	for _, name := range f.Selected {
+		field, _ := f.field(name)
+		columns = append(columns, field.Column)
+	}
+
+	return WithProjectionSpecs(columns...)
+}
+
+func (f *FieldsParams) Project(v any) (any, error) {
+	return Project(v, f.Selected)
+}
@@ -0,0 +1,40 @@
+package dhasar
+
+type FilterOperator string
+
+const (
+	FilterEqual              FilterOperator = "eq"
+	FilterNotEqual           FilterOperator = "ne"
+	FilterLessThan           FilterOperator = "lt"
+	FilterGreaterThanOrEqual FilterOperator = "gte"
+	FilterIn                 FilterOperator = "in"
+	FilterLike               FilterOperator = "like"
+	FilterIsNull             FilterOperator = "is_null"
+)
+
+type FilterFieldType int
+
+const (
+	FilterString FilterFieldType = iota
+	FilterInteger
+	FilterBoolean
+	FilterTime
+	FilterUUID
+)
+
+type FilterField struct {
+	Name      string
+	Column    string
+	Type      FilterFieldType
+	Operators []FilterOperator
+}
+
+type FilterFields []FilterField
+
+type FilterArgument struct {
+	Column   string
+	Operator FilterOperator
+	Value    any
+}
+
+type FilterArguments []FilterArgument
@@ -0,0 +1,23 @@
+package dhasar
+
+import "net/http"
+
+var (
+	ErrInvalidFilterParams = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_FILTER_PARAMS",
+		Message: "Filter parameter is not valid. Please pass valid filter parameters.",
+	}
+
+	ErrUnknownFilterField = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "UNKNOWN_FILTER_FIELD",
+		Message: "Filter field is not allowed.",
+	}
+
+	ErrUnknownFilterOperator = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "UNKNOWN_FILTER_OPERATOR",
+		Message: "Filter operator is not allowed for this field.",
+	}
+)
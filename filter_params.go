@@ -0,0 +1,164 @@
+package dhasar
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+var filterParamPattern = regexp.MustCompile(`^filter\[([^\[\]]+)\](?:\[([^\[\]]+)\])?$`)
+
+type FilterParams struct {
+	Fields    FilterFields
+	Arguments FilterArguments
+}
+
+func NewFilterParams(fields FilterFields) *FilterParams {
+	return &FilterParams{
+		Fields: fields,
+	}
+}
+
+func (f *FilterParams) field(name string) (FilterField, bool) {
+	for _, field := range f.Fields {
+		if field.Name == name {
+			if field.Column == "" {
+				field.Column = field.Name
+			}
+
+			return field, true
+		}
+	}
+
+	return FilterField{}, false
+}
+
+func (field FilterField) operatorAllowed(operator FilterOperator) bool {
+	switch operator {
+	case FilterEqual, FilterNotEqual, FilterLessThan, FilterGreaterThanOrEqual, FilterIn, FilterIsNull:
+	case FilterLike:
+		if field.Type != FilterString {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if len(field.Operators) == 0 {
+		return true
+	}
+
+	for _, allowed := range field.Operators {
+		if allowed == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *FilterParams) ParseFromContext(c echo.Context) error {
+	query := c.QueryParams()
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	details := []ErrorDetail{}
+	for _, key := range keys {
+		match := filterParamPattern.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+
+		operator := FilterEqual
+		if match[2] != "" {
+			operator = FilterOperator(match[2])
+		}
+
+		field, ok := f.field(match[1])
+		if !ok {
+			details = append(details, ErrorDetailsOf(ErrorLocationQuery, key, ErrUnknownFilterField)...)
+			continue
+		}
+
+		if !field.operatorAllowed(operator) {
+			details = append(details, ErrorDetailsOf(ErrorLocationQuery, key, ErrUnknownFilterOperator)...)
+			continue
+		}
+
+		value, errs := field.parse(operator, query[key])
+		if len(errs) > 0 {
+			details = append(details, ErrorDetailsOf(ErrorLocationQuery, key, errs...)...)
+			continue
+		}
+
+		f.Arguments = append(f.Arguments, FilterArgument{
+			Column:   field.Column,
+			Operator: operator,
+			Value:    value,
+		})
+	}
+
+	if len(details) > 0 {
+		return ErrInvalidFilterParams.WithDetails(details...)
+	}
+
+	return nil
+}
+
+func (f *FilterParams) Specs() Specification {
+	return WithFilterSpecs(f.Arguments...)
+}
+
+func (field FilterField) parse(operator FilterOperator, values []string) (any, []error) {
+	switch operator {
+	case FilterIsNull:
+		var value bool
+		return value, BoolBinder(&value)(values)
+	case FilterIn:
+		items := []any{}
+		errs := CSVBinder(&items, field.binder)(values)
+		return items, errs
+	}
+
+	var value any
+	return value, field.binder(&value)(values)
+}
+
+func (field FilterField) binder(dest *any) BinderFunc {
+	switch field.Type {
+	case FilterInteger:
+		return typedBinder(dest, IntBinder[int64])
+	case FilterBoolean:
+		return typedBinder(dest, BoolBinder)
+	case FilterTime:
+		return typedBinder(dest, TimeBinder)
+	case FilterUUID:
+		return typedBinder(dest, MustUUIDBinder)
+	}
+
+	return typedBinder(dest, func(v *string) BinderFunc {
+		return ValueBinder(v, ErrInvalidValue, func(value string) (string, error) {
+			return strings.TrimSpace(value), nil
+		})
+	})
+}
+
+func typedBinder[T any](dest *any, binder func(*T) BinderFunc) BinderFunc {
+	return func(values []string) []error {
+		var value T
+		if errs := binder(&value)(values); len(errs) > 0 {
+			return errs
+		}
+
+		*dest = value
+
+		return nil
+	}
+}
@@ -0,0 +1,224 @@
+package dhasar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthKind int
+
+const (
+	HealthLiveness HealthKind = iota
+	HealthReadiness
+)
+
+type HealthStatus string
+
+const (
+	HealthUp   HealthStatus = "UP"
+	HealthDown HealthStatus = "DOWN"
+)
+
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+type HealthCheck struct {
+	Name    string
+	Kind    HealthKind
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+}
+
+type HealthComponent struct {
+	Status    HealthStatus `json:"status"`
+	Error     string       `json:"error,omitempty"`
+	Took      string       `json:"took"`
+	CheckedAt time.Time    `json:"checked_at"`
+}
+
+type HealthReport struct {
+	Status     HealthStatus               `json:"status"`
+	Components map[string]HealthComponent `json:"components"`
+}
+
+type healthEntry struct {
+	mu        sync.Mutex
+	check     HealthCheck
+	result    HealthComponent
+	expiresAt time.Time
+}
+
+type Health struct {
+	mu       sync.RWMutex
+	entries  []*healthEntry
+	timeout  time.Duration
+	cacheTTL time.Duration
+}
+
+type HealthOption struct {
+	Timeout  time.Duration
+	CacheTTL time.Duration
+}
+
+func (h *Health) Register(check HealthCheck) error {
+	if check.Check == nil {
+		return fmt.Errorf("health check %s has no check function", check.Name)
+	}
+
+	if check.Timeout == 0 {
+		check.Timeout = h.timeout
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, entry := range h.entries {
+		if entry.check.Name == check.Name {
+			return fmt.Errorf("health check %s is already registered", check.Name)
+		}
+	}
+
+	h.entries = append(h.entries, &healthEntry{
+		check: check,
+	})
+
+	return nil
+}
+
+func (h *Health) registered(name string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, entry := range h.entries {
+		if entry.check.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Health) RegisterChecker(name string, kind HealthKind, checker any) error {
+	v, ok := checker.(HealthChecker)
+	if !ok {
+		return fmt.Errorf("health check %s: %T does not implement HealthChecker", name, checker)
+	}
+
+	return h.Register(HealthCheck{
+		Name:  name,
+		Kind:  kind,
+		Check: v.CheckHealth,
+	})
+}
+
+func (h *Health) Check(ctx context.Context, kind HealthKind) HealthReport {
+	h.mu.RLock()
+	entries := make([]*healthEntry, 0, len(h.entries))
+	for _, entry := range h.entries {
+		if entry.check.Kind == kind {
+			entries = append(entries, entry)
+		}
+	}
+	h.mu.RUnlock()
+
+	report := HealthReport{
+		Status:     HealthUp,
+		Components: make(map[string]HealthComponent, len(entries)),
+	}
+
+	results := make([]HealthComponent, len(entries))
+
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry *healthEntry) {
+			defer wg.Done()
+			results[i] = h.run(ctx, entry)
+		}(i, entry)
+	}
+	wg.Wait()
+
+	for i, entry := range entries {
+		report.Components[entry.check.Name] = results[i]
+		if results[i].Status != HealthUp {
+			report.Status = HealthDown
+		}
+	}
+
+	return report
+}
+
+func (h *Health) run(ctx context.Context, entry *healthEntry) HealthComponent {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(entry.expiresAt) {
+		return entry.result
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, entry.check.Timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- entry.check.Check(checkCtx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-checkCtx.Done():
+		err = checkCtx.Err()
+	}
+
+	result := HealthComponent{
+		Status:    HealthUp,
+		Took:      fmt.Sprintf("%d ms", time.Since(now).Milliseconds()),
+		CheckedAt: now,
+	}
+
+	if err != nil {
+		result.Status = HealthDown
+		result.Error = err.Error()
+	}
+
+	entry.result = result
+	entry.expiresAt = now.Add(h.cacheTTL)
+
+	return result
+}
+
+func (h *Health) Handler(kind HealthKind) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		report := h.Check(c.Request().Context(), kind)
+		if report.Status != HealthUp {
+			return c.JSON(http.StatusServiceUnavailable, report)
+		}
+
+		return c.JSON(http.StatusOK, report)
+	}
+}
+
+func NewHealth(opt *HealthOption) *Health {
+	h := &Health{
+		timeout:  opt.Timeout,
+		cacheTTL: opt.CacheTTL,
+	}
+
+	if h.timeout == 0 {
+		h.timeout = 2 * time.Second
+	}
+
+	if h.cacheTTL == 0 {
+		h.cacheTTL = 5 * time.Second
+	}
+
+	return h
+}
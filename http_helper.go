@@ -1,46 +1,160 @@
 package dhasar
 
-import "github.com/google/uuid"
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type BinderFunc func(values []string) []error
 
-func UUIDBinder(v *uuid.UUID) BinderFunc {
+type BinderInteger interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+func ValueBinder[T any](v *T, reason *Error, parse func(value string) (T, error)) BinderFunc {
 	return func(values []string) []error {
-		*v = uuid.MustParse(values[0])
+		if len(values) == 0 {
+			return []error{NewBinderError(-1, "", ErrRequiredValue, nil)}
+		}
+
+		value, err := parse(values[0])
+		if err != nil {
+			return []error{NewBinderError(-1, values[0], reason, err)}
+		}
+
+		*v = value
+
 		return nil
 	}
 }
 
-func MustUUIDBinder(v *uuid.UUID) BinderFunc {
+func SliceBinder[T any](v *[]T, binder func(*T) BinderFunc) BinderFunc {
 	return func(values []string) []error {
-		id, err := uuid.Parse(values[0])
-		if err != nil {
-			return []error{err}
+		result := make([]T, len(values))
+		errors := []error{}
+		for i, value := range values {
+			for _, err := range binder(&result[i])([]string{value}) {
+				errors = append(errors, indexBinderError(err, i))
+			}
+		}
+
+		if len(errors) > 0 {
+			return errors
 		}
 
-		*v = id
+		*v = result
 
 		return nil
 	}
 }
 
-func UUIDSliceBinder(v []uuid.UUID) BinderFunc {
+func CSVBinder[T any](v *[]T, binder func(*T) BinderFunc) BinderFunc {
 	return func(values []string) []error {
-		for i, idStr := range values {
-			v[i] = uuid.MustParse(idStr)
+		items := []string{}
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
 		}
 
+		return SliceBinder(v, binder)(items)
+	}
+}
+
+func IntBinder[T BinderInteger](v *T) BinderFunc {
+	return ValueBinder(v, ErrInvalidInteger, parseInteger[T])
+}
+
+func IntRangeBinder[T BinderInteger](v *T, min T, max T) BinderFunc {
+	return func(values []string) []error {
+		var value T
+		if errs := IntBinder(&value)(values); len(errs) > 0 {
+			return errs
+		}
+
+		if value < min || value > max {
+			return []error{NewBinderError(-1, values[0], ErrValueOutOfRange.Format(min, max), nil)}
+		}
+
+		*v = value
+
 		return nil
 	}
 }
 
+func BoolBinder(v *bool) BinderFunc {
+	return ValueBinder(v, ErrInvalidBoolean, strconv.ParseBool)
+}
+
+func TimeBinder(v *time.Time) BinderFunc {
+	return ValueBinder(v, ErrInvalidTime, func(value string) (time.Time, error) {
+		return time.Parse(time.RFC3339, value)
+	})
+}
+
+func DateBinder(v *time.Time) BinderFunc {
+	return ValueBinder(v, ErrInvalidDate, func(value string) (time.Time, error) {
+		return time.Parse(time.DateOnly, value)
+	})
+}
+
+func DurationBinder(v *time.Duration) BinderFunc {
+	return ValueBinder(v, ErrInvalidDuration, time.ParseDuration)
+}
+
+func EnumBinder[T ~string](v *T, allowed ...T) BinderFunc {
+	names := make([]string, 0, len(allowed))
+	for _, value := range allowed {
+		names = append(names, string(value))
+	}
+
+	reason := ErrInvalidEnum.Format(strings.Join(names, ", "))
+
+	return func(values []string) []error {
+		if len(values) == 0 {
+			return []error{NewBinderError(-1, "", ErrRequiredValue, nil)}
+		}
+
+		for _, value := range allowed {
+			if string(value) == values[0] {
+				*v = value
+				return nil
+			}
+		}
+
+		return []error{NewBinderError(-1, values[0], reason, nil)}
+	}
+}
+
+func UUIDBinder(v *uuid.UUID) BinderFunc {
+	return MustUUIDBinder(v)
+}
+
+func MustUUIDBinder(v *uuid.UUID) BinderFunc {
+	return ValueBinder(v, ErrInvalidUUID, uuid.Parse)
+}
+
+func UUIDSliceBinder(v []uuid.UUID) BinderFunc {
+	return MustUUIDSliceBinder(v)
+}
+
 func MustUUIDSliceBinder(v []uuid.UUID) BinderFunc {
 	return func(values []string) []error {
 		errors := []error{}
 		for i, idStr := range values {
+			if i >= len(v) {
+				errors = append(errors, NewBinderError(i, idStr, ErrTooManyValues.Format(len(v)), nil))
+				continue
+			}
+
 			id, err := uuid.Parse(idStr)
 			if err != nil {
-				errors = append(errors, err)
+				errors = append(errors, NewBinderError(i, idStr, ErrInvalidUUID, err))
 			}
 
 			v[i] = id
@@ -53,3 +167,39 @@ func MustUUIDSliceBinder(v []uuid.UUID) BinderFunc {
 		return nil
 	}
 }
+
+func UUIDSlicePtrBinder(v *[]uuid.UUID) BinderFunc {
+	return SliceBinder(v, MustUUIDBinder)
+}
+
+func UUIDCSVBinder(v *[]uuid.UUID) BinderFunc {
+	return CSVBinder(v, MustUUIDBinder)
+}
+
+func parseInteger[T BinderInteger](value string) (T, error) {
+	var zero T
+
+	if zero-1 > 0 {
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+
+		if uint64(T(n)) != n {
+			return zero, strconv.ErrRange
+		}
+
+		return T(n), nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return zero, err
+	}
+
+	if int64(T(n)) != n {
+		return zero, strconv.ErrRange
+	}
+
+	return T(n), nil
+}
@@ -1,9 +1,14 @@
 package dhasar
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/fikrirnurhidayat/x/logger"
 	"github.com/labstack/echo/v4"
@@ -13,27 +18,150 @@ import (
 )
 
 type HTTPServer struct {
-	Echo      *echo.Echo
-	Port      uint
-	Container *Container
-	Logger    logger.Logger
+	Echo            *echo.Echo
+	Port            uint
+	Container       *Container
+	Logger          logger.Logger
+	Health          *Health
+	ErrorRenderer   ErrorRenderer
+	DrainPeriod     time.Duration
+	ShutdownTimeout time.Duration
+	ready           atomic.Bool
 }
 
 type HTTPServerOption struct {
-	Container   *Container
-	HealthCheck echo.HandlerFunc
-	Bootstrap   func(*HTTPServer) error
+	Server          *ServerConfig
+	Container       *Container
+	Logger          logger.Logger
+	HealthCheck     echo.HandlerFunc
+	Health          *Health
+	ErrorRenderer   ErrorRenderer
+	Bootstrap       func(*HTTPServer) error
+	Requirements    []Requirement
+	DrainPeriod     time.Duration
+	ShutdownTimeout time.Duration
 }
 
 func (s *HTTPServer) HealthCheck(c echo.Context) error {
-	return c.NoContent(http.StatusOK)
+	return s.ReadinessCheck(c)
+}
+
+func (s *HTTPServer) LivenessCheck(c echo.Context) error {
+	return s.Health.Handler(HealthLiveness)(c)
+}
+
+func (s *HTTPServer) ReadinessCheck(c echo.Context) error {
+	report := s.Health.Check(c.Request().Context(), HealthReadiness)
+
+	server := HealthComponent{
+		Status:    HealthUp,
+		Took:      "0 ms",
+		CheckedAt: time.Now(),
+	}
+
+	if !s.Ready() {
+		server.Status = HealthDown
+		server.Error = "server is not accepting traffic"
+		report.Status = HealthDown
+	}
+
+	report.Components["server"] = server
+
+	if report.Status != HealthUp {
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+func (s *HTTPServer) Ready() bool {
+	return s.ready.Load()
+}
+
+func (s *HTTPServer) ReadinessGate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !s.Ready() {
+			return c.NoContent(http.StatusServiceUnavailable)
+		}
+
+		return next(c)
+	}
+}
+
+func (s *HTTPServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Port))
+	if err != nil {
+		s.Logger.Error("http/START", logger.String("error", err.Error()))
+		return err
+	}
+
+	s.Echo.Listener = listener
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.Echo.Start(listener.Addr().String())
+	}()
+
+	s.ready.Store(true)
+	s.Logger.Info("http/START", logger.String("addr", listener.Addr().String()))
+
+	select {
+	case err := <-errs:
+		s.ready.Store(false)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		s.Logger.Error("http/START", logger.String("error", err.Error()))
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.DrainPeriod+s.ShutdownTimeout)
+	defer cancel()
+
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-errs; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *HTTPServer) Shutdown(ctx context.Context) error {
+	s.ready.Store(false)
+	s.Logger.Info("http/DRAIN", logger.String("period", s.DrainPeriod.String()))
+
+	select {
+	case <-time.After(s.DrainPeriod):
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, s.ShutdownTimeout)
+	defer cancel()
+
+	if err := s.Echo.Shutdown(shutdownCtx); err != nil {
+		s.Logger.Error("http/SHUTDOWN", logger.String("error", err.Error()))
+		return err
+	}
+
+	s.Logger.Info("http/SHUTDOWN", logger.String("status", "OK!"))
+
+	return nil
 }
 
 func (server *HTTPServer) HTTPErrorHandler(err error, c echo.Context) {
 	if val, ok := err.(*Error); ok {
-		c.JSON(val.Code, echo.Map{
-			"error": val,
-		})
+		server.ErrorRenderer(c, val.Code, val)
+
+		return
+	}
+
+	if isBindingError(err) {
+		server.ErrorRenderer(c, http.StatusBadRequest, NewBindingError("", "", err))
 
 		return
 	}
@@ -44,16 +172,12 @@ func (server *HTTPServer) HTTPErrorHandler(err error, c echo.Context) {
 	}
 
 	if code == http.StatusNotFound {
-		c.JSON(code, echo.Map{
-			"error": ErrNotFound.Format(c.Request().Method, c.Request().URL),
-		})
+		server.ErrorRenderer(c, code, ErrNotFound.Format(c.Request().Method, c.Request().URL))
 
 		return
 	}
 
-	c.JSON(code, echo.Map{
-		"error": ErrInternalServer,
-	})
+	server.ErrorRenderer(c, code, ErrInternalServer)
 }
 
 func (s *HTTPServer) RequestLogger() echo.MiddlewareFunc {
@@ -67,7 +191,7 @@ func (s *HTTPServer) RequestLogger() echo.MiddlewareFunc {
 				logger.String("took", fmt.Sprintf("%d ms", v.Latency.Milliseconds())),
 			}
 
-			serverLogger := GetDep[logger.Logger](s.Container, "Logger")
+			serverLogger := s.Logger
 
 			if v.Error == nil {
 				serverLogger.Info("http/OK", args...)
@@ -105,11 +229,76 @@ func (s *HTTPServer) RequestLogger() echo.MiddlewareFunc {
 	})
 }
 
+func (s *HTTPServer) ContainerScope() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			scope := s.Container.Scope()
+			Supply[echo.Context](scope, c)
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(WithContainer(req.Context(), scope)))
+			c.Set(ContainerContextKey, scope)
+
+			defer func() {
+				if err := scope.Close(context.WithoutCancel(req.Context())); err != nil {
+					s.Logger.Warn("container/SCOPE_CLOSE", logger.String("error", err.Error()))
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
+
 func NewHTTPServer(opt *HTTPServerOption) (*HTTPServer, error) {
 	server := &HTTPServer{
-		Port:      viper.GetUint("server.port"),
-		Echo:      echo.New(),
-		Container: opt.Container,
+		Echo:            echo.New(),
+		Container:       opt.Container,
+		Logger:          opt.Logger,
+		Health:          opt.Health,
+		ErrorRenderer:   opt.ErrorRenderer,
+		DrainPeriod:     opt.DrainPeriod,
+		ShutdownTimeout: opt.ShutdownTimeout,
+	}
+
+	if server.Logger == nil && server.Container != nil {
+		if serverLogger, err := ResolveNamed[logger.Logger](server.Container, "Logger"); err == nil {
+			server.Logger = serverLogger
+		}
+	}
+
+	if server.Logger == nil {
+		return nil, errors.New("http server logger is required")
+	}
+
+	if opt.Server == nil {
+		opt.Server = &ServerConfig{
+			Port:            viper.GetUint("server.port"),
+			DrainPeriod:     viper.GetDuration("server.drain_period"),
+			ShutdownTimeout: viper.GetDuration("server.shutdown_timeout"),
+		}
+	}
+
+	server.Port = opt.Server.Port
+
+	if server.DrainPeriod == 0 {
+		server.DrainPeriod = opt.Server.DrainPeriod
+	}
+
+	if server.ShutdownTimeout == 0 {
+		server.ShutdownTimeout = opt.Server.ShutdownTimeout
+	}
+
+	if server.ShutdownTimeout == 0 {
+		server.ShutdownTimeout = 10 * time.Second
+	}
+
+	if server.ErrorRenderer == nil {
+		server.ErrorRenderer = EnvelopeErrorRenderer
+	}
+
+	if server.Health == nil {
+		server.Health = NewHealth(&HealthOption{})
 	}
 
 	if opt.HealthCheck == nil {
@@ -124,14 +313,35 @@ func NewHTTPServer(opt *HTTPServerOption) (*HTTPServer, error) {
 	server.Echo.Use(middleware.Secure())
 	server.Echo.Use(middleware.Timeout())
 	server.Echo.Use(middleware.RequestID())
+	if server.Container != nil {
+		server.Echo.Use(server.ContainerScope())
+	}
 	server.Echo.Use(server.RequestLogger())
 	server.Echo.Use(middleware.Recover())
-	server.Echo.GET("/health", opt.HealthCheck)
+	server.Echo.GET("/health", opt.HealthCheck, server.ReadinessGate)
+	server.Echo.GET("/health/live", server.LivenessCheck)
+	server.Echo.GET("/health/ready", server.ReadinessCheck)
 	server.Echo.HTTPErrorHandler = server.HTTPErrorHandler
 
 	if err := opt.Bootstrap(server); err != nil {
 		return nil, err
 	}
 
+	if server.Container != nil {
+		if err := server.Container.Verify(opt.Requirements...); err != nil {
+			return nil, err
+		}
+
+		for name, checker := range server.Container.HealthCheckers() {
+			if server.Health.registered(name) {
+				continue
+			}
+
+			if err := server.Health.RegisterChecker(name, HealthReadiness, checker); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return server, nil
 }
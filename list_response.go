@@ -0,0 +1,120 @@
+package dhasar
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fikrirnurhidayat/x/exists"
+	"github.com/labstack/echo/v4"
+)
+
+const HeaderXTotalCount = "X-Total-Count"
+
+type LinksJSON struct {
+	Self  string `json:"self"`
+	First string `json:"first"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last"`
+}
+
+type ListResponse[T any] struct {
+	Data       []T            `json:"data"`
+	Pagination PaginationJSON `json:"pagination"`
+	Links      LinksJSON      `json:"links"`
+}
+
+type ListResponseOption struct {
+	Headers bool
+}
+
+func (l LinksJSON) Header() string {
+	links := []string{}
+	for _, link := range []struct {
+		rel  string
+		href string
+	}{
+		{"self", l.Self},
+		{"first", l.First},
+		{"prev", l.Prev},
+		{"next", l.Next},
+		{"last", l.Last},
+	} {
+		if exists.String(link.href) {
+			links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, link.href, link.rel))
+		}
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func NewLinksJSON(c echo.Context, params PaginationParams, result PaginationResult) LinksJSON {
+	pageParam := params.PageParam
+	if !exists.String(pageParam) {
+		pageParam = DefaultPageParam
+	}
+
+	pageSizeParam := params.PageSizeParam
+	if !exists.String(pageSizeParam) {
+		pageSizeParam = DefaultPageSizeParam
+	}
+
+	link := func(page uint32) string {
+		u := *c.Request().URL
+		query := u.Query()
+		query.Set(pageParam, strconv.FormatUint(uint64(page), 10))
+		query.Set(pageSizeParam, strconv.FormatUint(uint64(result.PageSize), 10))
+		u.RawQuery = query.Encode()
+
+		return u.RequestURI()
+	}
+
+	lastPage := result.PageCount
+	if lastPage == 0 {
+		lastPage = 1
+	}
+
+	links := LinksJSON{
+		Self:  link(result.Page),
+		First: link(1),
+		Last:  link(lastPage),
+	}
+
+	if result.Page > 1 {
+		links.Prev = link(min(result.Page-1, lastPage))
+	}
+
+	if result.Page < lastPage {
+		links.Next = link(result.Page + 1)
+	}
+
+	return links
+}
+
+func NewListResponse[T any](c echo.Context, data []T, params PaginationParams, size uint32) ListResponse[T] {
+	params = params.Normalize()
+	result := NewPaginationResult(params, size)
+
+	if data == nil {
+		data = []T{}
+	}
+
+	return ListResponse[T]{
+		Data:       data,
+		Pagination: NewPaginationJSON(result),
+		Links:      NewLinksJSON(c, params, result),
+	}
+}
+
+func RespondList[T any](c echo.Context, data []T, params PaginationParams, size uint32, opt *ListResponseOption) error {
+	response := NewListResponse(c, data, params, size)
+
+	if opt != nil && opt.Headers {
+		c.Response().Header().Set("Link", response.Links.Header())
+		c.Response().Header().Set(HeaderXTotalCount, strconv.FormatUint(uint64(size), 10))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
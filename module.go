@@ -1,6 +1,10 @@
 package dhasar
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/fikrirnurhidayat/x/logger"
 	echo "github.com/labstack/echo/v4"
 )
@@ -14,7 +18,18 @@ func (m *Module) Wire(dependency *RootDependency) {
 }
 
 type RootDependency struct {
-	Logger logger.Logger
+	Logger               logger.Logger
+	Container            *Container
+	Config               *Config
+	SQLDatabaseManager   SQLDatabaseManager
+	TransactionManager   TransactionManager
+	RedisDatabaseManager RedisDatabaseManager
+}
+
+type RootDependencyOption struct {
+	Logger    logger.Logger
+	Container *Container
+	Config    *Config
 }
 
 type HTTPModule interface {
@@ -26,7 +41,7 @@ type HTTPModules []HTTPModule
 
 type CLIModule interface {
 	Wire(*RootDependency)
-	WireCommand()
+	WireCommand(root *Command) error
 }
 
 type CLIModules []CLIModule
@@ -36,3 +51,140 @@ func NewModule(logger logger.Logger) *RootDependency {
 		Logger: logger,
 	}
 }
+
+func NewRootDependency(opt *RootDependencyOption) (*RootDependency, error) {
+	dependency := &RootDependency{
+		Logger:    opt.Logger,
+		Container: opt.Container,
+		Config:    opt.Config,
+	}
+
+	if dependency.Config == nil {
+		dependency.Config = &Config{
+			ConfigView: NewConfigView(nil),
+		}
+	}
+
+	if dependency.Container == nil {
+		return dependency, nil
+	}
+
+	var err error
+
+	if dependency.SQLDatabaseManager, err = resolveOptional[SQLDatabaseManager](dependency.Container); err != nil {
+		return nil, err
+	}
+
+	if dependency.TransactionManager, err = resolveOptional[TransactionManager](dependency.Container); err != nil {
+		return nil, err
+	}
+
+	if dependency.RedisDatabaseManager, err = resolveOptional[RedisDatabaseManager](dependency.Container); err != nil {
+		return nil, err
+	}
+
+	return dependency, nil
+}
+
+func resolveOptional[T any](c *Container) (T, error) {
+	dep, err := Resolve[T](c)
+	if errors.Is(err, ErrDependencyNotFound) {
+		return dep, nil
+	}
+
+	return dep, err
+}
+
+type NamedModule interface {
+	Name() string
+}
+
+type DependentModule interface {
+	DependsOn() []string
+}
+
+func (modules HTTPModules) Sorted() (HTTPModules, error) {
+	return sortModules(modules)
+}
+
+func (modules CLIModules) Sorted() (CLIModules, error) {
+	return sortModules(modules)
+}
+
+func ModuleName(module any) string {
+	if v, ok := module.(NamedModule); ok {
+		return v.Name()
+	}
+
+	return fmt.Sprintf("%T", module)
+}
+
+func sortModules[M any](modules []M) ([]M, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	names := make([]string, 0, len(modules))
+	byName := make(map[string]M, len(modules))
+	for _, module := range modules {
+		name := ModuleName(module)
+		if _, ok := byName[name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrModuleDuplicate, name)
+		}
+
+		names = append(names, name)
+		byName[name] = module
+	}
+
+	state := make(map[string]int, len(modules))
+	sorted := make([]M, 0, len(modules))
+	path := []string{}
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			start := 0
+			for i, n := range path {
+				if n == name {
+					start = i
+				}
+			}
+
+			return fmt.Errorf("%w: %s", ErrModuleCycle, strings.Join(append(path[start:], name), " -> "))
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		if v, ok := any(byName[name]).(DependentModule); ok {
+			for _, dependency := range v.DependsOn() {
+				if _, ok := byName[dependency]; !ok {
+					return fmt.Errorf("%w: %s depends on %s", ErrModuleNotFound, name, dependency)
+				}
+
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+		sorted = append(sorted, byName[name])
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,9 @@
+package dhasar
+
+import "errors"
+
+var (
+	ErrModuleNotFound  = errors.New("module not found")
+	ErrModuleDuplicate = errors.New("module registered more than once")
+	ErrModuleCycle     = errors.New("module dependency cycle detected")
+)
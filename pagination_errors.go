@@ -0,0 +1,17 @@
+package dhasar
+
+import "net/http"
+
+var (
+	ErrInvalidPaginationParams = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_PAGINATION_PARAMS",
+		Message: "Pagination parameter is not valid. Please pass valid pagination parameters.",
+	}
+
+	ErrInvalidCursor = &Error{
+		Code:    http.StatusBadRequest,
+		Reason:  "INVALID_CURSOR",
+		Message: "Cursor is not valid. Please pass the cursor returned by the previous page.",
+	}
+)
@@ -4,11 +4,22 @@ import (
 	"math"
 
 	"github.com/fikrirnurhidayat/x/exists"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	DefaultPageParam            = "page"
+	DefaultPageSizeParam        = "page_size"
+	DefaultPageSize      uint32 = 10
+	DefaultMaxPageSize   uint32 = 100
 )
 
 type PaginationParams struct {
-	Page     uint32
-	PageSize uint32
+	Page          uint32
+	PageSize      uint32
+	PageParam     string
+	PageSizeParam string
+	MaxPageSize   uint32
 }
 
 func (params PaginationParams) Normalize() PaginationParams {
@@ -17,12 +28,71 @@ func (params PaginationParams) Normalize() PaginationParams {
 	}
 
 	if !exists.Number(params.PageSize) {
-		params.PageSize = 10
+		params.PageSize = DefaultPageSize
 	}
 
 	return params
 }
 
+func (params *PaginationParams) ParseFromContext(c echo.Context) error {
+	pageParam := params.PageParam
+	if !exists.String(pageParam) {
+		pageParam = DefaultPageParam
+	}
+
+	pageSizeParam := params.PageSizeParam
+	if !exists.String(pageSizeParam) {
+		pageSizeParam = DefaultPageSizeParam
+	}
+
+	maxPageSize := params.MaxPageSize
+	if !exists.Number(maxPageSize) {
+		maxPageSize = DefaultMaxPageSize
+	}
+
+	pageSizeDetails := []ErrorDetail{}
+	if values := c.QueryParams()[pageSizeParam]; len(values) > 0 {
+		errs := IntRangeBinder(&params.PageSize, 1, maxPageSize)(values)
+		pageSizeDetails = ErrorDetailsOf(ErrorLocationQuery, pageSizeParam, errs...)
+	}
+
+	pageSize := params.PageSize
+	if !exists.Number(pageSize) || len(pageSizeDetails) > 0 {
+		pageSize = DefaultPageSize
+	}
+
+	details := []ErrorDetail{}
+	if values := c.QueryParams()[pageParam]; len(values) > 0 {
+		errs := IntRangeBinder(&params.Page, 1, math.MaxUint32/pageSize+1)(values)
+		details = append(details, ErrorDetailsOf(ErrorLocationQuery, pageParam, errs...)...)
+	}
+
+	details = append(details, pageSizeDetails...)
+
+	if len(details) > 0 {
+		return ErrInvalidPaginationParams.WithDetails(details...)
+	}
+
+	*params = params.Normalize()
+
+	return nil
+}
+
+func (params PaginationParams) LimitSpecs() Specification {
+	return WithLimitSpecs(params.Normalize().Limit())
+}
+
+func (params PaginationParams) OffsetSpecs() Specification {
+	return WithOffsetSpecs(params.Normalize().Offset())
+}
+
+func WithPagination[T any](args ListArgs[T], params PaginationParams) ListArgs[T] {
+	args.Limit = params.LimitSpecs()
+	args.Offset = params.OffsetSpecs()
+
+	return args
+}
+
 func (params PaginationParams) Limit() uint32 {
 	return params.PageSize
 }
@@ -32,17 +102,12 @@ func (params PaginationParams) Offset() uint32 {
 }
 
 func NewPaginationParams(page uint32, pageSize uint32) PaginationParams {
-	params := PaginationParams{}
-
-	if !exists.Number(page) {
-		params.Page = 1
-	}
-
-	if !exists.Number(pageSize) {
-		params.Page = 10
+	params := PaginationParams{
+		Page:     page,
+		PageSize: pageSize,
 	}
 
-	return params
+	return params.Normalize()
 }
 
 type PaginationResult struct {
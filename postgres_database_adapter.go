@@ -1,8 +1,11 @@
 package dhasar
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/fikrirnurhidayat/x/logger"
 	_ "github.com/lib/pq"
@@ -13,7 +16,19 @@ type PostgresDatabaseAdapter struct {
 	logger logger.Logger
 }
 
+func (p *PostgresDatabaseAdapter) CheckHealth(ctx context.Context) error {
+	if p.db == nil {
+		return errors.New("postgres is not connected")
+	}
+
+	return p.db.PingContext(ctx)
+}
+
 func (p *PostgresDatabaseAdapter) Close() error {
+	if p.db == nil {
+		return nil
+	}
+
 	if err := p.db.Close(); err != nil {
 		p.logger.Error("postgres/CLOSE", logger.String("error", err.Error()))
 		return err
@@ -40,12 +55,40 @@ func (p *PostgresDatabaseAdapter) Connect(opt *PostgresDatabaseAdapterOption) (*
 }
 
 type PostgresDatabaseAdapterOption struct {
-	Username string
-	Password string
-	Host     string
-	Port     string
-	Name     string
-	SSLMode  string
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Name     string `mapstructure:"name"`
+	SSLMode  string `mapstructure:"ssl_mode"`
+}
+
+func (opt *PostgresDatabaseAdapterOption) Validate(prefix string) []error {
+	errs := []error{}
+
+	if opt.Host == "" {
+		errs = append(errs, &ConfigFieldError{prefix + ".host", "is required"})
+	}
+
+	if port, err := strconv.Atoi(opt.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, &ConfigFieldError{prefix + ".port", "must be a number between 1 and 65535"})
+	}
+
+	if opt.Username == "" {
+		errs = append(errs, &ConfigFieldError{prefix + ".username", "is required"})
+	}
+
+	if opt.Name == "" {
+		errs = append(errs, &ConfigFieldError{prefix + ".name", "is required"})
+	}
+
+	switch opt.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		errs = append(errs, &ConfigFieldError{prefix + ".ssl_mode", fmt.Sprintf("%q is not a valid ssl mode", opt.SSLMode)})
+	}
+
+	return errs
 }
 
 func NewPostgresDatabaseAdapter(logger logger.Logger) Adapter[*PostgresDatabaseAdapterOption, *sql.DB] {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
@@ -19,6 +20,7 @@ type PostgresRepository[Entity any, Specification any, Row any] struct {
 	primaryKey   string
 	filter       func(...Specification) sq.Sqlizer
 	scan         func(*sql.Rows) (Row, error)
+	targets      func(*Row) map[string]any
 	row          func(Entity) Row
 	values       func(Row) []any
 	entity       func(Row) Entity
@@ -45,6 +47,7 @@ type PostgresRepositoryOption[Entity any, Specification any, Row any] struct {
 	Logger             logger.Logger
 	Filter             func(...Specification) sq.Sqlizer
 	Scan               func(rows *sql.Rows) (Row, error)
+	Targets            func(row *Row) map[string]any
 	Entity             func(Row) Entity
 	Row                func(Entity) Row
 	Values             func(Row) []any
@@ -81,21 +84,30 @@ func (r *PostgresRepository[Entity, Specification, Row]) Delete(ctx context.Cont
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) Each(ctx context.Context, args ListArgs[Specification]) (Iterator[Entity], error) {
-	rows, err := r.query(ctx, args)
+	if IsBackwardCursor(args.Cursor) {
+		entities, err := r.List(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSliceIterator(entities), nil
+	}
+
+	rows, scan, err := r.query(ctx, args)
 	if err != nil {
 		return nil, err
 	}
 
 	return &PostgresIterator[Entity, Row]{
 		rows:     rows,
-		scan:     r.scan,
+		scan:     scan,
 		entity:   r.entity,
 		noEntity: r.noEntity,
 	}, nil
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) Get(ctx context.Context, specs ...Specification) (Entity, error) {
-	rows, err := r.query(ctx, ListArgs[Specification]{
+	rows, scan, err := r.query(ctx, ListArgs[Specification]{
 		Specifications: specs,
 		Limit:   WithLimitSpecs(1),
 	})
@@ -107,7 +119,7 @@ func (r *PostgresRepository[Entity, Specification, Row]) Get(ctx context.Context
 	defer rows.Close()
 
 	for rows.Next() {
-		row, err := r.scan(rows)
+		row, err := scan(rows)
 		if err != nil {
 			return r.noEntity, err
 		}
@@ -151,7 +163,7 @@ func (r *PostgresRepository[Entity, Specification, Row]) Exist(ctx context.Conte
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) List(ctx context.Context, args ListArgs[Specification]) ([]Entity, error) {
-	rows, err := r.query(ctx, args)
+	rows, scan, err := r.query(ctx, args)
 	if err != nil {
 		return r.noEntities, err
 	}
@@ -161,7 +173,7 @@ func (r *PostgresRepository[Entity, Specification, Row]) List(ctx context.Contex
 	defer rows.Close()
 
 	for rows.Next() {
-		row, err := r.scan(rows)
+		row, err := scan(rows)
 		if err != nil {
 			return r.noEntities, err
 		}
@@ -169,6 +181,10 @@ func (r *PostgresRepository[Entity, Specification, Row]) List(ctx context.Contex
 		entities = append(entities, r.entity(row))
 	}
 
+	if IsBackwardCursor(args.Cursor) {
+		slices.Reverse(entities)
+	}
+
 	return entities, nil
 }
 
@@ -228,6 +244,7 @@ func NewPostgresRepository[Entity any, Specification any, Row any](opt PostgresR
 		logger:     opt.Logger,
 		filter:     opt.Filter,
 		scan:       opt.Scan,
+		targets:    opt.Targets,
 		entity:     opt.Entity,
 		row:        opt.Row,
 		values:     opt.Values,
@@ -242,18 +259,46 @@ func NewPostgresRepository[Entity any, Specification any, Row any](opt PostgresR
 	return r, nil
 }
 
-func (r *PostgresRepository[Entity, Specification, Row]) query(ctx context.Context, args ListArgs[Specification]) (*sql.Rows, error) {
+func (r *PostgresRepository[Entity, Specification, Row]) query(ctx context.Context, args ListArgs[Specification]) (*sql.Rows, func(*sql.Rows) (Row, error), error) {
+	columns, err := ProjectColumns(r.columns, r.primaryKey, args.Projection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scan := r.scan
+	if !slices.Equal(columns, r.columns) {
+		if scan, err = ProjectedScan(columns, r.targets); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	builder := sq.
-		Select(r.columns...).
+		Select(columns...).
 		From(r.tableName).
 		Where(r.filter(args.Specifications...))
-	builder = r.dbm.Paginate(builder, args.Limit, args.Offset)
+
+	if filter, ok := args.Filter.(sq.Sqlizer); ok {
+		builder = builder.Where(filter)
+	}
+
+	sort := StableSort(args.Sort, r.primaryKey)
+
+	if err := ValidateCursor(sort, args.Cursor); err != nil {
+		return nil, nil, err
+	}
+
+	builder = r.dbm.Paginate(builder, sort, args.Cursor, args.Limit, args.Offset)
 	queryStr, queryArgs, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	rows, err := r.dbm.Querier(ctx).QueryContext(ctx, queryStr, queryArgs...)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return r.dbm.Querier(ctx).QueryContext(ctx, queryStr, queryArgs...)
+	return rows, scan, nil
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) makeUpsertSuffix() string {
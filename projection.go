@@ -0,0 +1,109 @@
+package dhasar
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+type ProjectionSpecification struct {
+	Columns []string
+}
+
+func WithProjectionSpecs(columns ...string) Specification {
+	return ProjectionSpecification{
+		Columns: columns,
+	}
+}
+
+func ProjectColumns(columns []string, primaryKey string, projection Specification) ([]string, error) {
+	spec, ok := projection.(ProjectionSpecification)
+	if !ok || len(spec.Columns) == 0 {
+		return columns, nil
+	}
+
+	projected := []string{}
+	if slices.Contains(columns, primaryKey) && !slices.Contains(spec.Columns, primaryKey) {
+		projected = append(projected, primaryKey)
+	}
+
+	for _, column := range spec.Columns {
+		if !slices.Contains(columns, column) {
+			return nil, fmt.Errorf("projection column %s is not a repository column", column)
+		}
+
+		if !slices.Contains(projected, column) {
+			projected = append(projected, column)
+		}
+	}
+
+	return projected, nil
+}
+
+func ProjectedScan[Row any](columns []string, targets func(*Row) map[string]any) (func(*sql.Rows) (Row, error), error) {
+	if targets == nil {
+		return nil, errors.New("projection requires repository targets")
+	}
+
+	return func(rows *sql.Rows) (Row, error) {
+		var row Row
+
+		fields := targets(&row)
+		dest := make([]any, 0, len(columns))
+		for _, column := range columns {
+			target, ok := fields[column]
+			if !ok {
+				return row, fmt.Errorf("no scan target for column %s", column)
+			}
+
+			dest = append(dest, target)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return row, err
+		}
+
+		return row, nil
+	}, nil
+}
+
+func Project(v any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, err
+	}
+
+	return projectJSON(decoded, fields), nil
+}
+
+func projectJSON(v any, fields []string) any {
+	switch value := v.(type) {
+	case []any:
+		for i, item := range value {
+			value[i] = projectJSON(item, fields)
+		}
+
+		return value
+	case map[string]any:
+		for key := range value {
+			if !slices.Contains(fields, key) {
+				delete(value, key)
+			}
+		}
+
+		return value
+	}
+
+	return v
+}
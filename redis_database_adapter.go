@@ -2,17 +2,37 @@ package dhasar
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/fikrirnurhidayat/x/logger"
 	"github.com/redis/go-redis/v9"
 )
 
 type RedisDatabaseAdapterOption struct {
-	Network  string
-	Addr     string
-	Username string
-	Password string
-	DB       int
+	Network  string `mapstructure:"network"`
+	Addr     string `mapstructure:"addr"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	DB       int    `mapstructure:"db"`
+}
+
+func (opt *RedisDatabaseAdapterOption) Validate(prefix string) []error {
+	errs := []error{}
+
+	if opt.Network != "tcp" && opt.Network != "unix" {
+		errs = append(errs, &ConfigFieldError{prefix + ".network", fmt.Sprintf("%q is not tcp or unix", opt.Network)})
+	}
+
+	if opt.Addr == "" {
+		errs = append(errs, &ConfigFieldError{prefix + ".addr", "is required"})
+	}
+
+	if opt.DB < 0 {
+		errs = append(errs, &ConfigFieldError{prefix + ".db", "must not be negative"})
+	}
+
+	return errs
 }
 
 type RedisDatabaseAdapter struct {
@@ -20,7 +40,19 @@ type RedisDatabaseAdapter struct {
 	logger logger.Logger
 }
 
+func (r *RedisDatabaseAdapter) CheckHealth(ctx context.Context) error {
+	if r.db == nil {
+		return errors.New("redis is not connected")
+	}
+
+	return r.db.Ping(ctx).Err()
+}
+
 func (r *RedisDatabaseAdapter) Close() error {
+	if r.db == nil {
+		return nil
+	}
+
 	if err := r.db.Close(); err != nil {
 		r.logger.Error("redis/CLOSE", logger.String("error", err.Error()))
 		return err
@@ -47,6 +79,8 @@ func (r *RedisDatabaseAdapter) Connect(opt *RedisDatabaseAdapterOption) (*redis.
 		return nil, err
 	}
 
+	r.db = db
+
 	r.logger.Debug("redis/CONNECT", logger.String("status", "OK!"))
 
 	return db, nil
@@ -23,6 +23,7 @@ type RedisRepository[Entity any, Specification any, EntityJSON any, FallbackRepo
 	Resource           string
 	RDBM               RedisDatabaseManager
 	Expiration         time.Duration
+	ExpirationFunc     func() time.Duration
 	Logger             logger.Logger
 	FallbackRepository FallbackRepository
 	Decode             Decoder[Entity, EntityJSON]
@@ -36,6 +37,14 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 	return r.RDBM.Key(ctx, fmt.Sprintf("%s.%s", r.Key, action), value)
 }
 
+func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository]) expiration() time.Duration {
+	if r.ExpirationFunc != nil {
+		return r.ExpirationFunc()
+	}
+
+	return r.Expiration
+}
+
 func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository]) Delete(ctx context.Context, specs ...Specification) error {
 	if err := r.FallbackRepository.Delete(ctx, specs...); err != nil {
 		return err
@@ -72,7 +81,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 			return exist, err
 		}
 
-		if err := r.RDBM.Set(ctx, key, exist, r.Expiration); err != nil {
+		if err := r.RDBM.Set(ctx, key, exist, r.expiration()); err != nil {
 			return exist, err
 		}
 
@@ -87,7 +96,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 				return exist, err
 			}
 
-			if err := r.RDBM.Set(ctx, key, exist, r.Expiration); err != nil {
+			if err := r.RDBM.Set(ctx, key, exist, r.expiration()); err != nil {
 				return exist, err
 			}
 
@@ -120,7 +129,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 			return entity, err
 		}
 
-		if err := r.RDBM.Set(ctx, key, entityJSON, r.Expiration); err != nil {
+		if err := r.RDBM.Set(ctx, key, entityJSON, r.expiration()); err != nil {
 			return entity, err
 		}
 
@@ -140,7 +149,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 				return entity, err
 			}
 
-			if err := r.RDBM.Set(ctx, key, entityJSON, r.Expiration); err != nil {
+			if err := r.RDBM.Set(ctx, key, entityJSON, r.expiration()); err != nil {
 				return entity, err
 			}
 
@@ -180,7 +189,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 			entitiesJSON = append(entitiesJSON, entityJSON)
 		}
 
-		if err := r.RDBM.Set(ctx, key, entitiesJSON, r.Expiration); err != nil {
+		if err := r.RDBM.Set(ctx, key, entitiesJSON, r.expiration()); err != nil {
 			r.Logger.Debug("redis.repository/LIST", logger.String("cache_key", key), logger.Any("cache_hit", err.Error()))
 			return entities, nil
 		}
@@ -231,7 +240,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 			return exist, err
 		}
 
-		if err := r.RDBM.Set(ctx, key, exist, r.Expiration); err != nil {
+		if err := r.RDBM.Set(ctx, key, exist, r.expiration()); err != nil {
 			return exist, err
 		}
 
@@ -246,7 +255,7 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 				return exist, err
 			}
 
-			if err := r.RDBM.Set(ctx, key, exist, r.Expiration); err != nil {
+			if err := r.RDBM.Set(ctx, key, exist, r.expiration()); err != nil {
 				return exist, err
 			}
 
@@ -262,6 +271,8 @@ func (r *RedisRepository[Entity, Specification, EntityJSON, FallbackRepository])
 type RedisRepositoryOption[Entity any, Specification any, EntityJSON any, FallbackRepository Repository[Entity, Specification]] struct {
 	Resource             string
 	RedisDatabaseManager RedisDatabaseManager
+	Expiration           time.Duration
+	ExpirationFunc       func() time.Duration
 	Logger               logger.Logger
 	FallbackRepository   FallbackRepository
 	Decode               Decoder[Entity, EntityJSON]
@@ -272,6 +283,8 @@ func NewRedisRepository[Entity any, Specification any, EntityJSON any, FallbackR
 	return &RedisRepository[Entity, Specification, EntityJSON, FallbackRepository]{
 		Resource:           opt.Resource,
 		RDBM:               opt.RedisDatabaseManager,
+		Expiration:         opt.Expiration,
+		ExpirationFunc:     opt.ExpirationFunc,
 		Logger:             opt.Logger,
 		FallbackRepository: opt.FallbackRepository,
 		Encode:             opt.Encode,
@@ -9,6 +9,9 @@ type ListArgs[T any] struct {
 	Sort           Specification
 	Limit          Specification
 	Offset         Specification
+	Cursor         Specification
+	Filter         Specification
+	Projection     Specification
 }
 
 type Repository[Entity any, Specification any] interface {
@@ -25,3 +28,28 @@ type Iterator[Entity any] interface {
 	Next() bool
 	Current() (Entity, error)
 }
+
+type SliceIterator[Entity any] struct {
+	entities []Entity
+	index    int
+}
+
+func (i *SliceIterator[Entity]) Next() bool {
+	if i.index >= len(i.entities) {
+		return false
+	}
+
+	i.index++
+
+	return true
+}
+
+func (i *SliceIterator[Entity]) Current() (Entity, error) {
+	return i.entities[i.index-1], nil
+}
+
+func NewSliceIterator[Entity any](entities []Entity) Iterator[Entity] {
+	return &SliceIterator[Entity]{
+		entities: entities,
+	}
+}
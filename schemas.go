@@ -15,3 +15,17 @@ func NewPaginationJSON(result PaginationResult) PaginationJSON {
 		Size:      result.Size,
 	}
 }
+
+type CursorPaginationJSON struct {
+	PaginationJSON
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+func NewCursorPaginationJSON(result PaginationResult, nextCursor string, prevCursor string) CursorPaginationJSON {
+	return CursorPaginationJSON{
+		PaginationJSON: NewPaginationJSON(result),
+		NextCursor:     nextCursor,
+		PrevCursor:     prevCursor,
+	}
+}
@@ -0,0 +1,78 @@
+package dhasar
+
+import sq "github.com/Masterminds/squirrel"
+
+type CursorSpecification struct {
+	Cursor Cursor
+}
+
+func WithCursorSpecs(cursor Cursor) Specification {
+	return CursorSpecification{
+		Cursor: cursor,
+	}
+}
+
+func (spec CursorSpecification) Seek(sort SortSpecification) sq.Sqlizer {
+	predicate := sq.Or{}
+	for i, arg := range sort.Arguments {
+		column := string(arg.Column)
+		value := spec.Cursor.Values[i]
+
+		and := sq.And{}
+		for j := 0; j < i; j++ {
+			and = append(and, sq.Eq{string(sort.Arguments[j].Column): spec.Cursor.Values[j]})
+		}
+
+		if (arg.Direction == SortAscending) != spec.Cursor.Backward {
+			and = append(and, sq.Gt{column: value})
+		} else {
+			and = append(and, sq.Lt{column: value})
+		}
+
+		predicate = append(predicate, and)
+	}
+
+	return predicate
+}
+
+func StableSort(sort Specification, primaryKey string) SortSpecification {
+	v, _ := sort.(SortSpecification)
+	args := append(SortArguments{}, v.Arguments...)
+
+	for _, arg := range args {
+		if arg.Column == SortColumn(primaryKey) {
+			return SortSpecification{Arguments: args}
+		}
+	}
+
+	if primaryKey != "" {
+		args = append(args, SortArgument{Column: SortColumn(primaryKey)})
+	}
+
+	return SortSpecification{Arguments: args}
+}
+
+func ValidateCursor(sort Specification, cursor Specification) error {
+	spec, ok := cursor.(CursorSpecification)
+	if !ok {
+		return nil
+	}
+
+	v, _ := sort.(SortSpecification)
+	if len(v.Arguments) == 0 || len(v.Arguments) != len(spec.Cursor.Columns) || len(spec.Cursor.Columns) != len(spec.Cursor.Values) {
+		return ErrInvalidCursor
+	}
+
+	for i, arg := range v.Arguments {
+		if arg.Column != spec.Cursor.Columns[i] {
+			return ErrInvalidCursor
+		}
+	}
+
+	return nil
+}
+
+func IsBackwardCursor(cursor Specification) bool {
+	spec, ok := cursor.(CursorSpecification)
+	return ok && spec.Cursor.Backward
+}
@@ -0,0 +1,90 @@
+package dhasar
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	_ "modernc.org/sqlite"
+)
+
+func TestCursorPaginationWithDuplicateSortValues(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, status TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := []string{"active", "active", "active", "active", "active", "blocked", "blocked"}
+	for i, status := range statuses {
+		if _, err := db.Exec("INSERT INTO users (id, status) VALUES (?, ?)", i+1, status); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, sort := range []Specification{nil, Sort(), Sort(SortArgument{Column: "status"})} {
+		t.Run(fmt.Sprintf("%v", sort), func(t *testing.T) {
+			m := &SQLDatabaseManagerImpl{}
+			stable := StableSort(sort, "id")
+			seen := map[int]bool{}
+
+			var cursor Specification
+			for page := 0; page < len(statuses); page++ {
+				if err := ValidateCursor(stable, cursor); err != nil {
+					t.Fatalf("cursor rejected: %v", err)
+				}
+
+				query, args, err := m.Paginate(sq.Select("id", "status").From("users"), stable, cursor, WithLimitSpecs(2)).ToSql()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				rows, err := db.Query(query, args...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var id int
+				var status string
+				count := 0
+				for rows.Next() {
+					if err := rows.Scan(&id, &status); err != nil {
+						t.Fatal(err)
+					}
+
+					if seen[id] {
+						t.Fatalf("row %d returned twice", id)
+					}
+
+					seen[id] = true
+					count++
+				}
+				rows.Close()
+
+				if count == 0 {
+					break
+				}
+
+				lastID, lastStatus := id, status
+				cursor = WithCursorSpecs(CursorFrom(sort, "id", false, func(column SortColumn) any {
+					if column == "id" {
+						return lastID
+					}
+
+					return lastStatus
+				}))
+			}
+
+			if len(seen) != len(statuses) {
+				t.Fatalf("expected %d rows, got %d", len(statuses), len(seen))
+			}
+		})
+	}
+}
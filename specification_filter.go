@@ -0,0 +1,39 @@
+package dhasar
+
+import sq "github.com/Masterminds/squirrel"
+
+type FilterSpecification struct {
+	Arguments FilterArguments
+}
+
+func WithFilterSpecs(args ...FilterArgument) Specification {
+	return FilterSpecification{
+		Arguments: args,
+	}
+}
+
+func (spec FilterSpecification) ToSql() (string, []any, error) {
+	and := sq.And{}
+	for _, arg := range spec.Arguments {
+		switch arg.Operator {
+		case FilterEqual, FilterIn:
+			and = append(and, sq.Eq{arg.Column: arg.Value})
+		case FilterNotEqual:
+			and = append(and, sq.NotEq{arg.Column: arg.Value})
+		case FilterLessThan:
+			and = append(and, sq.Lt{arg.Column: arg.Value})
+		case FilterGreaterThanOrEqual:
+			and = append(and, sq.GtOrEq{arg.Column: arg.Value})
+		case FilterLike:
+			and = append(and, sq.Like{arg.Column: arg.Value})
+		case FilterIsNull:
+			if isNull, _ := arg.Value.(bool); isNull {
+				and = append(and, sq.Eq{arg.Column: nil})
+			} else {
+				and = append(and, sq.NotEq{arg.Column: nil})
+			}
+		}
+	}
+
+	return and.ToSql()
+}
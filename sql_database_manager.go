@@ -46,15 +46,35 @@ type SQLDatabaseManagerImpl struct {
 }
 
 func (m *SQLDatabaseManagerImpl) Paginate(builder squirrel.SelectBuilder, specs ...Specification) squirrel.SelectBuilder {
+	var sort SortSpecification
+	var cursor *CursorSpecification
+
 	for _, spec := range specs {
 		switch v := spec.(type) {
 		case LimitSpecification:
 			builder = builder.Limit(uint64(v.Limit))
 		case OffsetSpecification:
 			builder = builder.Offset(uint64(v.Offset))
+		case SortSpecification:
+			sort = v
+		case CursorSpecification:
+			cursor = &v
 		}
 	}
 
+	if cursor != nil && ValidateCursor(sort, *cursor) == nil {
+		builder = builder.Where(cursor.Seek(sort))
+	}
+
+	for _, arg := range sort.Arguments {
+		direction := "ASC"
+		if (arg.Direction == SortDescending) != (cursor != nil && cursor.Cursor.Backward) {
+			direction = "DESC"
+		}
+
+		builder = builder.OrderBy(fmt.Sprintf("%s %s", arg.Column, direction))
+	}
+
 	return builder
 }
 
@@ -1,7 +1,9 @@
 package dhasar
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/fikrirnurhidayat/x/logger"
 	_ "modernc.org/sqlite"
@@ -13,10 +15,32 @@ type SQLiteDatabaseAdapter struct {
 }
 
 type SQLiteDatabaseAdapterOption struct {
-	FilePath string
+	FilePath string `mapstructure:"file_path"`
+}
+
+func (opt *SQLiteDatabaseAdapterOption) Validate(prefix string) []error {
+	errs := []error{}
+
+	if opt.FilePath == "" {
+		errs = append(errs, &ConfigFieldError{prefix + ".file_path", "is required"})
+	}
+
+	return errs
+}
+
+func (s *SQLiteDatabaseAdapter) CheckHealth(ctx context.Context) error {
+	if s.db == nil {
+		return errors.New("sqlite is not connected")
+	}
+
+	return s.db.PingContext(ctx)
 }
 
 func (s *SQLiteDatabaseAdapter) Close() error {
+	if s.db == nil {
+		return nil
+	}
+
 	if err := s.db.Close(); err != nil {
 		s.logger.Error("sqlite/CLOSE", logger.String("error", err.Error()))
 		return err